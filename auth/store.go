@@ -42,6 +42,8 @@ var (
 	ErrRoleAlreadyExist = errors.New("auth: role already exists")
 	ErrRoleNotFound     = errors.New("auth: role not found")
 	ErrAuthFailed       = errors.New("auth: authentication failed, invalid user ID or password")
+	ErrRoleNotGranted   = errors.New("auth: role is not granted to the user")
+	ErrPermNotGranted   = errors.New("auth: permission is not granted to the role")
 )
 
 type AuthStore interface {
@@ -72,20 +74,30 @@ type AuthStore interface {
 	// UserGrant grants a role to the user
 	UserGrant(r *pb.AuthUserGrantRequest) (*pb.AuthUserGrantResponse, error)
 
+	// UserRevoke revokes a role from the user
+	UserRevoke(r *pb.AuthUserRevokeRequest) (*pb.AuthUserRevokeResponse, error)
+
 	// RoleAdd adds a new role
 	RoleAdd(r *pb.AuthRoleAddRequest) (*pb.AuthRoleAddResponse, error)
 
+	// RoleDelete deletes a role and revokes it from all users
+	RoleDelete(r *pb.AuthRoleDeleteRequest) (*pb.AuthRoleDeleteResponse, error)
+
 	// RoleGrant grants a permission to a role
 	RoleGrant(r *pb.AuthRoleGrantRequest) (*pb.AuthRoleGrantResponse, error)
 
+	// RoleRevoke revokes a permission from a role
+	RoleRevoke(r *pb.AuthRoleRevokeRequest) (*pb.AuthRoleRevokeResponse, error)
+
 	// UsernameFromToken gets a username from the given Token
 	UsernameFromToken(token string) (string, bool)
 
 	// IsPutPermitted checks put permission of the user
 	IsPutPermitted(header *pb.RequestHeader, key string) bool
 
-	// IsRangePermitted checks range permission of the user
-	IsRangePermitted(header *pb.RequestHeader, key string) bool
+	// IsRangePermitted checks range permission of the user on the keys in
+	// [key, rangeEnd), or on key alone if rangeEnd is empty
+	IsRangePermitted(header *pb.RequestHeader, key, rangeEnd string) bool
 }
 
 type authStore struct {
@@ -293,6 +305,39 @@ func (as *authStore) UserGrant(r *pb.AuthUserGrantRequest) (*pb.AuthUserGrantRes
 	return &pb.AuthUserGrantResponse{}, nil
 }
 
+func (as *authStore) UserRevoke(r *pb.AuthUserRevokeRequest) (*pb.AuthUserRevokeResponse, error) {
+	tx := as.be.BatchTx()
+	tx.Lock()
+	defer tx.Unlock()
+
+	_, vs := tx.UnsafeRange(authUsersBucketName, []byte(r.User), nil, 0)
+	if len(vs) != 1 {
+		return nil, ErrUserNotFound
+	}
+
+	user := &authpb.User{}
+	err := user.Unmarshal(vs[0])
+	if err != nil {
+		return nil, err
+	}
+
+	idx := sort.SearchStrings(user.Roles, r.Role)
+	if idx == len(user.Roles) || user.Roles[idx] != r.Role {
+		return nil, ErrRoleNotGranted
+	}
+	user.Roles = append(user.Roles[:idx], user.Roles[idx+1:]...)
+
+	marshaledUser, merr := user.Marshal()
+	if merr != nil {
+		return nil, merr
+	}
+
+	tx.UnsafePut(authUsersBucketName, user.Name, marshaledUser)
+
+	plog.Noticef("revoked role %s from user %s", r.Role, r.User)
+	return &pb.AuthUserRevokeResponse{}, nil
+}
+
 func (as *authStore) RoleAdd(r *pb.AuthRoleAddRequest) (*pb.AuthRoleAddResponse, error) {
 	tx := as.be.BatchTx()
 	tx.Lock()
@@ -319,6 +364,43 @@ func (as *authStore) RoleAdd(r *pb.AuthRoleAddRequest) (*pb.AuthRoleAddResponse,
 	return &pb.AuthRoleAddResponse{}, nil
 }
 
+func (as *authStore) RoleDelete(r *pb.AuthRoleDeleteRequest) (*pb.AuthRoleDeleteResponse, error) {
+	tx := as.be.BatchTx()
+	tx.Lock()
+	defer tx.Unlock()
+
+	_, vs := tx.UnsafeRange(authRolesBucketName, []byte(r.Name), nil, 0)
+	if len(vs) != 1 {
+		return nil, ErrRoleNotFound
+	}
+
+	tx.UnsafeDelete(authRolesBucketName, []byte(r.Name))
+
+	// revoke the deleted role from the users which are granted it
+	_, vs = tx.UnsafeRange(authUsersBucketName, []byte{0}, []byte{0xff}, 0)
+	for _, v := range vs {
+		user := &authpb.User{}
+		if err := user.Unmarshal(v); err != nil {
+			return nil, err
+		}
+
+		idx := sort.SearchStrings(user.Roles, r.Name)
+		if idx == len(user.Roles) || user.Roles[idx] != r.Name {
+			continue
+		}
+		user.Roles = append(user.Roles[:idx], user.Roles[idx+1:]...)
+
+		marshaledUser, merr := user.Marshal()
+		if merr != nil {
+			return nil, merr
+		}
+		tx.UnsafePut(authUsersBucketName, user.Name, marshaledUser)
+	}
+
+	plog.Noticef("deleted role %s", r.Name)
+	return &pb.AuthRoleDeleteResponse{}, nil
+}
+
 func (as *authStore) UsernameFromToken(token string) (string, bool) {
 	simpleTokensMu.RLock()
 	defer simpleTokensMu.RUnlock()
@@ -357,17 +439,14 @@ func (as *authStore) RoleGrant(r *pb.AuthRoleGrantRequest) (*pb.AuthRoleGrantRes
 		return nil, err
 	}
 
-	idx := sort.Search(len(role.KeyPermission), func(i int) bool {
-		return bytes.Compare(role.KeyPermission[i].Key, []byte(r.Perm.Key)) >= 0
-	})
-
-	if idx < len(role.KeyPermission) && bytes.Equal(role.KeyPermission[idx].Key, r.Perm.Key) {
+	if idx := findPerm(role.KeyPermission, r.Perm.Key, r.Perm.RangeEnd); idx != -1 {
 		// update existing permission
 		role.KeyPermission[idx].PermType = r.Perm.PermType
 	} else {
 		// append new permission to the role
 		newPerm := &authpb.Permission{
 			Key:      []byte(r.Perm.Key),
+			RangeEnd: []byte(r.Perm.RangeEnd),
 			PermType: r.Perm.PermType,
 		}
 
@@ -388,7 +467,80 @@ func (as *authStore) RoleGrant(r *pb.AuthRoleGrantRequest) (*pb.AuthRoleGrantRes
 	return &pb.AuthRoleGrantResponse{}, nil
 }
 
-func (as *authStore) isOpPermitted(userName string, key string, write bool, read bool) bool {
+func (as *authStore) RoleRevoke(r *pb.AuthRoleRevokeRequest) (*pb.AuthRoleRevokeResponse, error) {
+	tx := as.be.BatchTx()
+	tx.Lock()
+	defer tx.Unlock()
+
+	_, vs := tx.UnsafeRange(authRolesBucketName, []byte(r.Name), nil, 0)
+	if len(vs) != 1 {
+		return nil, ErrRoleNotFound
+	}
+
+	role := &authpb.Role{}
+	err := role.Unmarshal(vs[0])
+	if err != nil {
+		plog.Errorf("failed to unmarshal a role %s: %s", r.Name, err)
+		return nil, err
+	}
+
+	idx := findPerm(role.KeyPermission, []byte(r.Key), []byte(r.RangeEnd))
+	if idx == -1 {
+		return nil, ErrPermNotGranted
+	}
+	role.KeyPermission = append(role.KeyPermission[:idx], role.KeyPermission[idx+1:]...)
+
+	marshaledRole, merr := role.Marshal()
+	if merr != nil {
+		plog.Errorf("failed to marshal updated role %s: %s", r.Name, merr)
+		return nil, merr
+	}
+
+	tx.UnsafePut(authRolesBucketName, []byte(r.Name), marshaledRole)
+
+	plog.Noticef("revoked key %s from role %s", r.Key, r.Name)
+	return &pb.AuthRoleRevokeResponse{}, nil
+}
+
+// findPerm returns the index of the permission on exactly [key, rangeEnd)
+// in the sorted perms, or -1 if there is no such permission.
+func findPerm(perms []*authpb.Permission, key, rangeEnd []byte) int {
+	idx := sort.Search(len(perms), func(i int) bool {
+		return bytes.Compare(perms[i].Key, key) >= 0
+	})
+	for ; idx < len(perms) && bytes.Equal(perms[idx].Key, key); idx++ {
+		if bytes.Equal(perms[idx].RangeEnd, rangeEnd) {
+			return idx
+		}
+	}
+	return -1
+}
+
+// permCovers returns true if the permission applies to every key in
+// [key, rangeEnd), or to key alone if rangeEnd is empty. A permission
+// without a range end covers only its key; a range end of "\x00" covers
+// all keys from the permission key, as it does for a request.
+func permCovers(perm *authpb.Permission, key, rangeEnd []byte) bool {
+	if len(perm.RangeEnd) == 0 {
+		return len(rangeEnd) == 0 && bytes.Equal(perm.Key, key)
+	}
+	if bytes.Compare(key, perm.Key) < 0 {
+		return false
+	}
+	if bytes.Equal(perm.RangeEnd, []byte{0}) {
+		return true
+	}
+	if len(rangeEnd) == 0 {
+		return bytes.Compare(key, perm.RangeEnd) < 0
+	}
+	if bytes.Equal(rangeEnd, []byte{0}) {
+		// open ended, so only an open ended permission covers it
+		return false
+	}
+	return bytes.Compare(rangeEnd, perm.RangeEnd) <= 0
+}
+
+func (as *authStore) isOpPermitted(userName string, key, rangeEnd string, write bool, read bool) bool {
 	// TODO(mitake): this function would be costly so we need a caching mechanism
 	if !as.isAuthEnabled() {
 		return true
@@ -426,7 +578,7 @@ func (as *authStore) isOpPermitted(userName string, key string, write bool, read
 		}
 
 		for _, perm := range role.KeyPermission {
-			if bytes.Equal(perm.Key, []byte(key)) {
+			if permCovers(perm, []byte(key), []byte(rangeEnd)) {
 				if perm.PermType == authpb.READWRITE {
 					return true
 				}
@@ -446,11 +598,11 @@ func (as *authStore) isOpPermitted(userName string, key string, write bool, read
 }
 
 func (as *authStore) IsPutPermitted(header *pb.RequestHeader, key string) bool {
-	return as.isOpPermitted(header.Username, key, true, false)
+	return as.isOpPermitted(header.Username, key, "", true, false)
 }
 
-func (as *authStore) IsRangePermitted(header *pb.RequestHeader, key string) bool {
-	return as.isOpPermitted(header.Username, key, false, true)
+func (as *authStore) IsRangePermitted(header *pb.RequestHeader, key, rangeEnd string) bool {
+	return as.isOpPermitted(header.Username, key, rangeEnd, false, true)
 }
 
 func (as *authStore) isAuthEnabled() bool {
@@ -18,6 +18,7 @@ import (
 	"os"
 	"testing"
 
+	"github.com/coreos/etcd/auth/authpb"
 	pb "github.com/coreos/etcd/etcdserver/etcdserverpb"
 	"github.com/coreos/etcd/mvcc/backend"
 )
@@ -204,3 +205,158 @@ func TestUserGrant(t *testing.T) {
 		t.Fatalf("expected %v, got %v", ErrUserNotFound, err)
 	}
 }
+
+func TestUserRevoke(t *testing.T) {
+	b, tPath := backend.NewDefaultTmpBackend()
+	defer func() {
+		b.Close()
+		os.Remove(tPath)
+	}()
+
+	as := NewAuthStore(b)
+
+	_, err := as.UserAdd(&pb.AuthUserAddRequest{Name: "foo"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = as.RoleAdd(&pb.AuthRoleAddRequest{Name: "role-test"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = as.UserGrant(&pb.AuthUserGrantRequest{User: "foo", Role: "role-test"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// revokes a granted role from the user
+	_, err = as.UserRevoke(&pb.AuthUserRevokeRequest{User: "foo", Role: "role-test"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// revokes a role which is no longer granted
+	_, err = as.UserRevoke(&pb.AuthUserRevokeRequest{User: "foo", Role: "role-test"})
+	if err != ErrRoleNotGranted {
+		t.Fatalf("expected %v, got %v", ErrRoleNotGranted, err)
+	}
+
+	// revokes a role from a non-existing user
+	_, err = as.UserRevoke(&pb.AuthUserRevokeRequest{User: "foo-test", Role: "role-test"})
+	if err != ErrUserNotFound {
+		t.Fatalf("expected %v, got %v", ErrUserNotFound, err)
+	}
+}
+
+func TestRoleDelete(t *testing.T) {
+	b, tPath := backend.NewDefaultTmpBackend()
+	defer func() {
+		b.Close()
+		os.Remove(tPath)
+	}()
+
+	as := NewAuthStore(b)
+
+	_, err := as.UserAdd(&pb.AuthUserAddRequest{Name: "foo"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = as.RoleAdd(&pb.AuthRoleAddRequest{Name: "role-test"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = as.UserGrant(&pb.AuthUserGrantRequest{User: "foo", Role: "role-test"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// deletes an existing role
+	_, err = as.RoleDelete(&pb.AuthRoleDeleteRequest{Name: "role-test"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the deleted role must be revoked from the user
+	_, err = as.UserRevoke(&pb.AuthUserRevokeRequest{User: "foo", Role: "role-test"})
+	if err != ErrRoleNotGranted {
+		t.Fatalf("expected %v, got %v", ErrRoleNotGranted, err)
+	}
+
+	// deletes a non-existing role
+	_, err = as.RoleDelete(&pb.AuthRoleDeleteRequest{Name: "role-test"})
+	if err != ErrRoleNotFound {
+		t.Fatalf("expected %v, got %v", ErrRoleNotFound, err)
+	}
+}
+
+func TestRoleGrantRange(t *testing.T) {
+	b, tPath := backend.NewDefaultTmpBackend()
+	defer func() {
+		b.Close()
+		os.Remove(tPath)
+	}()
+
+	as := NewAuthStore(b)
+
+	_, err := as.UserAdd(&pb.AuthUserAddRequest{Name: "foo"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = as.RoleAdd(&pb.AuthRoleAddRequest{Name: "role-test"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = as.UserGrant(&pb.AuthUserGrantRequest{User: "foo", Role: "role-test"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// grants read permission on the keys prefixed by "a"
+	perm := &authpb.Permission{Key: []byte("a"), RangeEnd: []byte("b"), PermType: authpb.READ}
+	_, err = as.RoleGrant(&pb.AuthRoleGrantRequest{Name: "role-test", Perm: perm})
+	if err != nil {
+		t.Fatal(err)
+	}
+	as.AuthEnable()
+
+	header := &pb.RequestHeader{Username: "foo"}
+	tests := []struct {
+		key      string
+		rangeEnd string
+		wread    bool
+	}{
+		{"a", "", true},
+		{"abc", "", true},
+		{"b", "", false},
+		{"", "", false},
+		// ranges inside the grant
+		{"a", "b", true},
+		{"abc", "abd", true},
+		// ranges past the grant
+		{"a", "c", false},
+		{"abc", "\x00", false},
+		{"", "b", false},
+	}
+	for i, tt := range tests {
+		if r := as.IsRangePermitted(header, tt.key, tt.rangeEnd); r != tt.wread {
+			t.Errorf("#%d: range permitted on [%q, %q) = %v, want %v", i, tt.key, tt.rangeEnd, r, tt.wread)
+		}
+		if as.IsPutPermitted(header, tt.key) {
+			t.Errorf("#%d: put permitted on %q, want denied", i, tt.key)
+		}
+	}
+
+	// revokes the range permission
+	_, err = as.RoleRevoke(&pb.AuthRoleRevokeRequest{Name: "role-test", Key: "a", RangeEnd: "b"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if as.IsRangePermitted(header, "abc", "") {
+		t.Errorf("range permitted on %q after revoke, want denied", "abc")
+	}
+
+	// revokes a permission which is no longer granted
+	_, err = as.RoleRevoke(&pb.AuthRoleRevokeRequest{Name: "role-test", Key: "a", RangeEnd: "b"})
+	if err != ErrPermNotGranted {
+		t.Fatalf("expected %v, got %v", ErrPermNotGranted, err)
+	}
+}
@@ -33,8 +33,11 @@ type (
 	AuthUserDeleteResponse         pb.AuthUserDeleteResponse
 	AuthUserChangePasswordResponse pb.AuthUserChangePasswordResponse
 	AuthUserGrantResponse          pb.AuthUserGrantResponse
+	AuthUserRevokeResponse         pb.AuthUserRevokeResponse
 	AuthRoleAddResponse            pb.AuthRoleAddResponse
+	AuthRoleDeleteResponse         pb.AuthRoleDeleteResponse
 	AuthRoleGrantResponse          pb.AuthRoleGrantResponse
+	AuthRoleRevokeResponse         pb.AuthRoleRevokeResponse
 
 	PermissionType authpb.Permission_Type
 )
@@ -61,14 +64,26 @@ type Auth interface {
 	// UserChangePassword changes a password of a user.
 	UserChangePassword(ctx context.Context, name string, password string) (*AuthUserChangePasswordResponse, error)
 
-	// UserGrant grants a role to a user.
-	UserGrant(ctx context.Context, user string, role string) (*AuthUserGrantResponse, error)
+	// UserGrantRole grants a role to a user.
+	UserGrantRole(ctx context.Context, user string, role string) (*AuthUserGrantResponse, error)
+
+	// UserRevokeRole revokes a role from a user.
+	UserRevokeRole(ctx context.Context, user string, role string) (*AuthUserRevokeResponse, error)
 
 	// RoleAdd adds a new role to an etcd cluster.
 	RoleAdd(ctx context.Context, name string) (*AuthRoleAddResponse, error)
 
-	// RoleGrant grants a permission to a role.
-	RoleGrant(ctx context.Context, name string, key string, permType PermissionType) (*AuthRoleGrantResponse, error)
+	// RoleDelete deletes a role and revokes it from all users.
+	RoleDelete(ctx context.Context, name string) (*AuthRoleDeleteResponse, error)
+
+	// RoleGrantPermission grants a permission on the keys [key, rangeEnd) to a role.
+	// An empty rangeEnd grants the permission on key alone. To grant a permission on
+	// a prefix, pass GetPrefixRangeEnd(key) as rangeEnd; "\x00" grants it on all
+	// keys from key.
+	RoleGrantPermission(ctx context.Context, name string, key, rangeEnd string, permType PermissionType) (*AuthRoleGrantResponse, error)
+
+	// RoleRevokePermission revokes the permission on the keys [key, rangeEnd) from a role.
+	RoleRevokePermission(ctx context.Context, name string, key, rangeEnd string) (*AuthRoleRevokeResponse, error)
 }
 
 type auth struct {
@@ -112,25 +127,41 @@ func (auth *auth) UserChangePassword(ctx context.Context, name string, password
 	return (*AuthUserChangePasswordResponse)(resp), rpctypes.Error(err)
 }
 
-func (auth *auth) UserGrant(ctx context.Context, user string, role string) (*AuthUserGrantResponse, error) {
+func (auth *auth) UserGrantRole(ctx context.Context, user string, role string) (*AuthUserGrantResponse, error) {
 	resp, err := auth.remote.UserGrant(ctx, &pb.AuthUserGrantRequest{User: user, Role: role})
 	return (*AuthUserGrantResponse)(resp), rpctypes.Error(err)
 }
 
+func (auth *auth) UserRevokeRole(ctx context.Context, user string, role string) (*AuthUserRevokeResponse, error) {
+	resp, err := auth.remote.UserRevoke(ctx, &pb.AuthUserRevokeRequest{User: user, Role: role})
+	return (*AuthUserRevokeResponse)(resp), rpctypes.Error(err)
+}
+
 func (auth *auth) RoleAdd(ctx context.Context, name string) (*AuthRoleAddResponse, error) {
 	resp, err := auth.remote.RoleAdd(ctx, &pb.AuthRoleAddRequest{Name: name})
 	return (*AuthRoleAddResponse)(resp), rpctypes.Error(err)
 }
 
-func (auth *auth) RoleGrant(ctx context.Context, name string, key string, permType PermissionType) (*AuthRoleGrantResponse, error) {
+func (auth *auth) RoleDelete(ctx context.Context, name string) (*AuthRoleDeleteResponse, error) {
+	resp, err := auth.remote.RoleDelete(ctx, &pb.AuthRoleDeleteRequest{Name: name})
+	return (*AuthRoleDeleteResponse)(resp), rpctypes.Error(err)
+}
+
+func (auth *auth) RoleGrantPermission(ctx context.Context, name string, key, rangeEnd string, permType PermissionType) (*AuthRoleGrantResponse, error) {
 	perm := &authpb.Permission{
 		Key:      []byte(key),
+		RangeEnd: []byte(rangeEnd),
 		PermType: authpb.Permission_Type(permType),
 	}
 	resp, err := auth.remote.RoleGrant(ctx, &pb.AuthRoleGrantRequest{Name: name, Perm: perm})
 	return (*AuthRoleGrantResponse)(resp), rpctypes.Error(err)
 }
 
+func (auth *auth) RoleRevokePermission(ctx context.Context, name string, key, rangeEnd string) (*AuthRoleRevokeResponse, error) {
+	resp, err := auth.remote.RoleRevoke(ctx, &pb.AuthRoleRevokeRequest{Name: name, Key: key, RangeEnd: rangeEnd})
+	return (*AuthRoleRevokeResponse)(resp), rpctypes.Error(err)
+}
+
 func StrToPermissionType(s string) (PermissionType, error) {
 	val, ok := authpb.Permission_Type_value[strings.ToUpper(s)]
 	if ok {
@@ -0,0 +1,80 @@
+// Copyright 2016 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clientv3
+
+import (
+	"time"
+
+	"golang.org/x/net/context"
+)
+
+// autoSync periodically refreshes the client endpoints from the cluster
+// member list.
+func (c *Client) autoSync() {
+	t := time.NewTicker(c.cfg.AutoSyncInterval)
+	defer t.Stop()
+	for {
+		select {
+		case <-t.C:
+		case <-c.ctx.Done():
+			return
+		}
+		ctx, cancel := context.WithTimeout(c.ctx, c.cfg.AutoSyncInterval)
+		// a failed sync keeps the current endpoints until the next one
+		c.Sync(ctx)
+		cancel()
+	}
+}
+
+// Sync replaces the client endpoints with the client URLs of the cluster
+// members. Members that have not started yet, and so have no client URLs,
+// are left out; the endpoints are kept if no member has any.
+func (c *Client) Sync(ctx context.Context) error {
+	resp, err := c.MemberList(ctx)
+	if err != nil {
+		return err
+	}
+	var eps []string
+	for _, m := range resp.Members {
+		eps = append(eps, m.ClientURLs...)
+	}
+	if len(eps) != 0 {
+		c.SetEndpoints(eps...)
+	}
+	return nil
+}
+
+// SetEndpoints replaces the endpoints the client reconnects to. The active
+// connection is kept until it fails; reconnects start from its endpoint
+// if it is still listed, and from the first endpoint otherwise.
+func (c *Client) SetEndpoints(eps ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var cur string
+	if c.firstEndpoint < len(c.cfg.Endpoints) {
+		cur = c.cfg.Endpoints[c.firstEndpoint]
+	}
+	c.firstEndpoint = 0
+	for i, ep := range eps {
+		if ep == cur {
+			c.firstEndpoint = i
+			break
+		}
+	}
+	c.epMu.Lock()
+	c.cfg.Endpoints = eps
+	c.epMu.Unlock()
+	c.dropReadConns(eps)
+}
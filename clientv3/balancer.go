@@ -0,0 +1,184 @@
+// Copyright 2016 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clientv3
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+// errReadConnDialing is returned for a read picked to an endpoint whose
+// connection is still being dialed.
+var errReadConnDialing = errors.New("etcdclient: read connection is being dialed")
+
+// readEndpointDownTime is how long an endpoint that failed a read, or the
+// active connection, stays out of the read rotation.
+var readEndpointDownTime = 5 * time.Second
+
+// ReadBalancer chooses the endpoint that serves each serializable read.
+// Linearizable reads and writes always use the client's active connection.
+type ReadBalancer interface {
+	// Pick returns one of eps, the endpoints currently in rotation, to
+	// serve the next read. eps is never empty.
+	Pick(eps []string) string
+}
+
+type roundRobinBalancer struct {
+	mu   sync.Mutex
+	next int
+}
+
+// NewRoundRobinBalancer returns a ReadBalancer that cycles through the
+// endpoints in rotation.
+func NewRoundRobinBalancer() ReadBalancer { return &roundRobinBalancer{} }
+
+func (rr *roundRobinBalancer) Pick(eps []string) string {
+	rr.mu.Lock()
+	defer rr.mu.Unlock()
+	ep := eps[rr.next%len(eps)]
+	rr.next++
+	return ep
+}
+
+// readConns holds the per-endpoint connections of balanced reads.
+type readConns struct {
+	mu      sync.Mutex
+	conns   map[string]*grpc.ClientConn
+	dialing map[string]bool      // endpoints being dialed in the background
+	down    map[string]time.Time // endpoints out of rotation until the given time
+	closed  bool
+}
+
+// readEndpoints returns the endpoints in the read rotation. If every
+// endpoint is out of rotation, all are returned so reads are still tried.
+func (c *Client) readEndpoints() []string {
+	c.reads.mu.Lock()
+	defer c.reads.mu.Unlock()
+	now := time.Now()
+	all := c.Endpoints()
+	var eps []string
+	for _, ep := range all {
+		if t, ok := c.reads.down[ep]; ok && now.Before(t) {
+			continue
+		}
+		eps = append(eps, ep)
+	}
+	if len(eps) == 0 {
+		return all
+	}
+	return eps
+}
+
+// readConn returns the endpoint picked by the read balancer with its
+// connection. If the endpoint has no connection yet, it is dialed in the
+// background and readConn fails with errReadConnDialing, so the read is
+// served by the active connection rather than waiting on the dial.
+func (c *Client) readConn() (string, *grpc.ClientConn, error) {
+	ep := c.cfg.ReadBalancer.Pick(c.readEndpoints())
+	c.reads.mu.Lock()
+	defer c.reads.mu.Unlock()
+	if c.reads.closed {
+		return "", nil, ErrClientClosed
+	}
+	if conn, ok := c.reads.conns[ep]; ok {
+		return ep, conn, nil
+	}
+	if !c.reads.dialing[ep] {
+		if c.reads.dialing == nil {
+			c.reads.dialing = make(map[string]bool)
+		}
+		c.reads.dialing[ep] = true
+		go c.dialRead(ep)
+	}
+	return "", nil, errReadConnDialing
+}
+
+// dialRead dials the connection of balanced reads to ep. The dial is
+// canceled on client Close.
+func (c *Client) dialRead(ep string) {
+	conn, err := c.Dial(ep)
+	c.reads.mu.Lock()
+	defer c.reads.mu.Unlock()
+	delete(c.reads.dialing, ep)
+	if err != nil {
+		c.readFailedLocked(ep, nil)
+		return
+	}
+	if c.reads.closed {
+		conn.Close()
+		return
+	}
+	if c.reads.conns == nil {
+		c.reads.conns = make(map[string]*grpc.ClientConn)
+	}
+	c.reads.conns[ep] = conn
+}
+
+// readFailed takes ep out of the read rotation for readEndpointDownTime
+// and closes its connection, if it is still conn. A nil conn closes any
+// connection to ep.
+func (c *Client) readFailed(ep string, conn *grpc.ClientConn) {
+	c.reads.mu.Lock()
+	defer c.reads.mu.Unlock()
+	c.readFailedLocked(ep, conn)
+}
+
+// readFailedLocked is readFailed with c.reads.mu held.
+func (c *Client) readFailedLocked(ep string, conn *grpc.ClientConn) {
+	if c.reads.down == nil {
+		c.reads.down = make(map[string]time.Time)
+	}
+	c.reads.down[ep] = time.Now().Add(readEndpointDownTime)
+	if cur, ok := c.reads.conns[ep]; ok && (conn == nil || cur == conn) {
+		cur.Close()
+		delete(c.reads.conns, ep)
+	}
+}
+
+// closeReadConns closes the connections of balanced reads.
+func (c *Client) closeReadConns() {
+	c.reads.mu.Lock()
+	defer c.reads.mu.Unlock()
+	c.reads.closed = true
+	for ep, conn := range c.reads.conns {
+		conn.Close()
+		if st, _ := conn.State(); st != grpc.Shutdown {
+			// wait so grpc doesn't leak sleeping goroutines
+			conn.WaitForStateChange(context.Background(), st)
+		}
+		delete(c.reads.conns, ep)
+	}
+}
+
+// dropReadConns closes the connections of balanced reads to endpoints not
+// in eps.
+func (c *Client) dropReadConns(eps []string) {
+	keep := make(map[string]bool, len(eps))
+	for _, ep := range eps {
+		keep[ep] = true
+	}
+	c.reads.mu.Lock()
+	defer c.reads.mu.Unlock()
+	for ep, conn := range c.reads.conns {
+		if !keep[ep] {
+			conn.Close()
+			delete(c.reads.conns, ep)
+		}
+	}
+}
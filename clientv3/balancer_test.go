@@ -0,0 +1,111 @@
+// Copyright 2016 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clientv3
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+func TestRoundRobinBalancer(t *testing.T) {
+	b := NewRoundRobinBalancer()
+	var got []string
+	for i := 0; i < 4; i++ {
+		got = append(got, b.Pick([]string{"a", "b", "c"}))
+	}
+	if w := []string{"a", "b", "c", "a"}; !reflect.DeepEqual(got, w) {
+		t.Errorf("picks = %v, want %v", got, w)
+	}
+}
+
+func TestReadEndpoints(t *testing.T) {
+	c := &Client{cfg: Config{Endpoints: []string{"a", "b", "c"}}}
+	if eps := c.readEndpoints(); !reflect.DeepEqual(eps, c.cfg.Endpoints) {
+		t.Errorf("endpoints = %v, want %v", eps, c.cfg.Endpoints)
+	}
+
+	c.readFailed("b", nil)
+	if eps, w := c.readEndpoints(), []string{"a", "c"}; !reflect.DeepEqual(eps, w) {
+		t.Errorf("endpoints = %v, want %v", eps, w)
+	}
+
+	// all down; try them all anyway
+	c.readFailed("a", nil)
+	c.readFailed("c", nil)
+	if eps := c.readEndpoints(); !reflect.DeepEqual(eps, c.cfg.Endpoints) {
+		t.Errorf("endpoints = %v, want %v", eps, c.cfg.Endpoints)
+	}
+
+	// back in rotation after the down time
+	c.reads.down["b"] = time.Now().Add(-time.Second)
+	if eps, w := c.readEndpoints(), []string{"b"}; !reflect.DeepEqual(eps, w) {
+		t.Errorf("endpoints = %v, want %v", eps, w)
+	}
+}
+
+func TestBalancedReadDialing(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	// nothing listens on the endpoint and there is no DialTimeout, so its
+	// dial never completes
+	cfg := Config{Endpoints: []string{"127.0.0.1:1"}, ReadBalancer: NewRoundRobinBalancer()}
+	c := &Client{cfg: cfg, conn: &grpc.ClientConn{}, ctx: ctx, cancel: func() {}}
+	kv := NewKV(c).(*kv)
+	fkc := &fakeKVClient{}
+	kv.remote = fkc
+
+	donec := make(chan error, 1)
+	go func() {
+		_, err := kv.Get(context.TODO(), "foo", WithSerializable())
+		donec <- err
+	}()
+	select {
+	case err := <-donec:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("read blocked on dialing the picked endpoint")
+	}
+	// served by the active connection meanwhile
+	if fkc.calls != 1 {
+		t.Errorf("calls = %d, want 1", fkc.calls)
+	}
+	c.reads.mu.Lock()
+	dialing := c.reads.dialing["127.0.0.1:1"]
+	c.reads.mu.Unlock()
+	if !dialing {
+		t.Errorf("expected endpoint to be dialed in the background")
+	}
+
+	// the background dial ends with the client
+	cancel()
+	for i := 0; ; i++ {
+		c.reads.mu.Lock()
+		dialing = c.reads.dialing["127.0.0.1:1"]
+		c.reads.mu.Unlock()
+		if !dialing {
+			break
+		}
+		if i == 100 {
+			t.Fatalf("background dial not canceled")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright 2016 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clientv3
+
+import (
+	"sync"
+	"time"
+)
+
+// defaultBreakerCooldown is how long a tripped endpoint is skipped if
+// Config.BreakerCooldown is not set.
+var defaultBreakerCooldown = 5 * time.Second
+
+// circuitBreaker counts the consecutive connection failures of each
+// endpoint. Once an endpoint fails threshold times in a row it is open,
+// so reconnects skip it, until the cooldown passes. After the cooldown a
+// single failure opens it again. It is shared by all users of a Client.
+// A nil circuitBreaker allows every endpoint.
+type circuitBreaker struct {
+	threshold int
+	cooldown  time.Duration
+
+	mu    sync.Mutex
+	fails map[string]int
+	open  map[string]time.Time // open endpoints and when they close
+}
+
+func newCircuitBreaker(threshold int, cooldown time.Duration) *circuitBreaker {
+	if threshold <= 0 {
+		return nil
+	}
+	if cooldown <= 0 {
+		cooldown = defaultBreakerCooldown
+	}
+	return &circuitBreaker{
+		threshold: threshold,
+		cooldown:  cooldown,
+		fails:     make(map[string]int),
+		open:      make(map[string]time.Time),
+	}
+}
+
+// allow returns false if ep is open.
+func (cb *circuitBreaker) allow(ep string) bool {
+	if cb == nil {
+		return true
+	}
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	t, ok := cb.open[ep]
+	if !ok {
+		return true
+	}
+	if time.Now().Before(t) {
+		return false
+	}
+	// half-open; the next failure trips the breaker again
+	delete(cb.open, ep)
+	cb.fails[ep] = cb.threshold - 1
+	return true
+}
+
+// failure records a failed connection to ep.
+func (cb *circuitBreaker) failure(ep string) {
+	if cb == nil {
+		return
+	}
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	if _, ok := cb.open[ep]; ok {
+		return
+	}
+	cb.fails[ep]++
+	if cb.fails[ep] >= cb.threshold {
+		cb.open[ep] = time.Now().Add(cb.cooldown)
+	}
+}
+
+// success records a working connection to ep.
+func (cb *circuitBreaker) success(ep string) {
+	if cb == nil {
+		return
+	}
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	delete(cb.fails, ep)
+	delete(cb.open, ep)
+}
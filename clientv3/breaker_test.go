@@ -0,0 +1,157 @@
+// Copyright 2016 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clientv3
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/coreos/etcd/etcdserver/api/v3rpc/rpctypes"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+func TestCircuitBreakerDisabled(t *testing.T) {
+	cb := newCircuitBreaker(0, time.Hour)
+	if cb != nil {
+		t.Fatalf("breaker = %+v, want nil", cb)
+	}
+	cb.failure("a")
+	if !cb.allow("a") {
+		t.Errorf("disabled breaker should allow all endpoints")
+	}
+}
+
+func TestCircuitBreakerTrip(t *testing.T) {
+	cb := newCircuitBreaker(3, time.Hour)
+	for i := 0; i < 2; i++ {
+		cb.failure("a")
+	}
+	if !cb.allow("a") {
+		t.Fatalf("breaker opened before threshold")
+	}
+	// a success resets the count
+	cb.success("a")
+	for i := 0; i < 2; i++ {
+		cb.failure("a")
+	}
+	if !cb.allow("a") {
+		t.Fatalf("breaker opened before threshold")
+	}
+	cb.failure("a")
+	if cb.allow("a") {
+		t.Fatalf("breaker should be open after 3 failures")
+	}
+	if !cb.allow("b") {
+		t.Errorf("other endpoints should be allowed")
+	}
+}
+
+func TestCircuitBreakerCooldown(t *testing.T) {
+	cb := newCircuitBreaker(3, 10*time.Millisecond)
+	for i := 0; i < 3; i++ {
+		cb.failure("a")
+	}
+	if cb.allow("a") {
+		t.Fatalf("breaker should be open")
+	}
+	time.Sleep(20 * time.Millisecond)
+	if !cb.allow("a") {
+		t.Fatalf("breaker should allow an attempt after the cooldown")
+	}
+	// a single failure trips the half-open breaker
+	cb.failure("a")
+	if cb.allow("a") {
+		t.Errorf("breaker should be open after a failure when half-open")
+	}
+}
+
+// TestCircuitBreakerConcurrent has many goroutines retry one failing
+// endpoint; once the breaker opens, none of them dial it.
+func TestCircuitBreakerConcurrent(t *testing.T) {
+	const (
+		threshold  = 5
+		goroutines = 100
+		retries    = 10
+	)
+	cb := newCircuitBreaker(threshold, time.Hour)
+	var dials int32
+	var wg sync.WaitGroup
+	wg.Add(goroutines)
+	for i := 0; i < goroutines; i++ {
+		go func() {
+			defer wg.Done()
+			for j := 0; j < retries; j++ {
+				if !cb.allow("a") {
+					continue
+				}
+				atomic.AddInt32(&dials, 1)
+				cb.failure("a")
+			}
+		}()
+	}
+	wg.Wait()
+
+	if cb.allow("a") {
+		t.Fatalf("breaker should be open")
+	}
+	// each goroutine may have passed the check before the breaker opened
+	if n := atomic.LoadInt32(&dials); n > threshold+goroutines-1 {
+		t.Errorf("dials = %d, want at most %d", n, threshold+goroutines-1)
+	}
+}
+
+func TestRetryConnectionBreaker(t *testing.T) {
+	errTransport := grpc.Errorf(codes.Unavailable, "transport is closing")
+	tests := []struct {
+		err error
+
+		wopen bool
+	}{
+		{errTransport, true},
+		{errConnUnhealthy, true},
+		// the member is reachable
+		{rpctypes.ErrGRPCNoLeader, false},
+		{rpctypes.ErrGRPCInvalidAuthToken, false},
+	}
+	for i, tt := range tests {
+		cfg := Config{
+			Endpoints:   []string{"a", "b"},
+			RetryDialer: func(*Client) (*grpc.ClientConn, error) { return nil, nil },
+		}
+		c := &Client{cfg: cfg, ctx: context.Background(), cancel: func() {}, breaker: newCircuitBreaker(1, time.Hour)}
+		c.retryConnection(tt.err)
+		if open := !c.breaker.allow("a"); open != tt.wopen {
+			t.Errorf("#%d: open = %v, want %v", i, open, tt.wopen)
+		}
+	}
+}
+
+func TestDialEndpointListAllOpen(t *testing.T) {
+	// nothing listens on the endpoints
+	eps := []string{"127.0.0.1:1", "127.0.0.1:2"}
+	cfg := Config{Endpoints: eps, DialTimeout: 100 * time.Millisecond}
+	c := &Client{cfg: cfg, ctx: context.Background(), breaker: newCircuitBreaker(1, time.Hour)}
+	for _, ep := range eps {
+		c.breaker.failure(ep)
+	}
+	// all open; the endpoints are still dialed
+	if _, err := dialEndpointList(c); err != grpc.ErrClientConnTimeout {
+		t.Errorf("err = %v, want %v", err, grpc.ErrClientConnTimeout)
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright 2016 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clientv3
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultRetryBudgetRatio is the share of successful requests a
+	// client may retry if Config.RetryBudgetRatio is not set.
+	DefaultRetryBudgetRatio = 0.2
+	// DefaultRetryBudgetMinPerSecond is the number of retries per second
+	// a client may make regardless of its successful requests if
+	// Config.RetryBudgetMinPerSecond is not set.
+	DefaultRetryBudgetMinPerSecond = 10
+)
+
+// retryBudgetWindow is how long unspent retries of the budget accumulate.
+const retryBudgetWindow = 10 * time.Second
+
+// retryBudget is a token bucket bounding the retries of all requests of a
+// Client. Each retry takes a token; each successful attempt adds ratio of
+// a token, and minPerSec tokens are added every second, so a client keeps
+// some retries when all its requests fail. Tokens accumulate up to
+// retryBudgetWindow of the minimum rate. A nil retryBudget allows every
+// retry.
+type retryBudget struct {
+	ratio     float64
+	minPerSec float64
+	max       float64
+
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+func newRetryBudget(ratio float64, minPerSec int) *retryBudget {
+	if ratio < 0 {
+		return nil
+	}
+	if ratio == 0 {
+		ratio = DefaultRetryBudgetRatio
+	}
+	if minPerSec == 0 {
+		minPerSec = DefaultRetryBudgetMinPerSecond
+	}
+	if minPerSec < 0 {
+		minPerSec = 0
+	}
+	max := float64(minPerSec) * retryBudgetWindow.Seconds()
+	if max < 1 {
+		max = 1
+	}
+	return &retryBudget{
+		ratio:     ratio,
+		minPerSec: float64(minPerSec),
+		max:       max,
+		tokens:    max,
+		last:      time.Now(),
+	}
+}
+
+// withdraw takes a token for a retry. It returns false if the budget is
+// exhausted, so the request should not be retried.
+func (b *retryBudget) withdraw() bool {
+	if b == nil {
+		return true
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.refill()
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+// deposit records a successful attempt.
+func (b *retryBudget) deposit() {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.refill()
+	b.add(b.ratio)
+}
+
+// refill adds the tokens of the minimum rate since the last refill.
+func (b *retryBudget) refill() {
+	now := time.Now()
+	b.add(b.minPerSec * now.Sub(b.last).Seconds())
+	b.last = now
+}
+
+func (b *retryBudget) add(n float64) {
+	b.tokens += n
+	if b.tokens > b.max {
+		b.tokens = b.max
+	}
+}
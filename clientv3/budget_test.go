@@ -0,0 +1,92 @@
+// Copyright 2016 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clientv3
+
+import (
+	"testing"
+	"time"
+
+	"github.com/coreos/etcd/etcdserver/api/v3rpc/rpctypes"
+	"golang.org/x/net/context"
+)
+
+func TestRetryBudgetDisabled(t *testing.T) {
+	b := newRetryBudget(-1, 0)
+	if b != nil {
+		t.Fatalf("budget = %+v, want nil", b)
+	}
+	for i := 0; i < 1000; i++ {
+		if !b.withdraw() {
+			t.Fatalf("disabled budget should allow all retries")
+		}
+	}
+	b.deposit()
+}
+
+func TestRetryBudgetExhaust(t *testing.T) {
+	b := newRetryBudget(0.5, 1)
+	b.minPerSec = 0 // no refill over time
+	n := int(retryBudgetWindow.Seconds())
+	for i := 0; i < n; i++ {
+		if !b.withdraw() {
+			t.Fatalf("#%d: retry refused before the budget is spent", i)
+		}
+	}
+	if b.withdraw() {
+		t.Fatalf("retry allowed after the budget is spent")
+	}
+
+	// two successes pay for one retry
+	b.deposit()
+	if b.withdraw() {
+		t.Fatalf("retry allowed after a single success")
+	}
+	b.deposit()
+	b.deposit()
+	if !b.withdraw() {
+		t.Fatalf("retry refused after two successes")
+	}
+}
+
+func TestRetryBudgetRefill(t *testing.T) {
+	b := newRetryBudget(0, 1)
+	b.tokens = 0
+	b.last = time.Now().Add(-2 * time.Second)
+	for i := 0; i < 2; i++ {
+		if !b.withdraw() {
+			t.Fatalf("#%d: retry refused after refill", i)
+		}
+	}
+	if b.withdraw() {
+		t.Fatalf("retry allowed beyond the refill")
+	}
+
+	// tokens accumulate up to the window
+	b.last = time.Now().Add(-time.Hour)
+	b.deposit()
+	if b.tokens != b.max {
+		t.Errorf("tokens = %v, want %v", b.tokens, b.max)
+	}
+}
+
+func TestRetryWaitBudget(t *testing.T) {
+	c := &Client{retries: newRetryBudget(0, 1)}
+	c.retries.tokens = 0
+	c.retries.minPerSec = 0
+	err := c.retryWait(context.TODO(), 1, rpctypes.ErrGRPCNoLeader)
+	if err != rpctypes.ErrNoLeader {
+		t.Errorf("err = %v, want %v", err, rpctypes.ErrNoLeader)
+	}
+}
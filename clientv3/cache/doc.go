@@ -0,0 +1,42 @@
+// Copyright 2016 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cache is a clientv3 wrapper that serves single-key reads from a
+// bounded in-memory cache, kept up to date by watching the cached prefixes.
+//
+// First, create a client:
+//
+//	cli, err := clientv3.New(clientv3.Config{Endpoints: []string{"localhost:2379"}})
+//	if err != nil {
+//		// handle error!
+//	}
+//
+// Next, wrap the client KV with a cache of up to 1000 keys under "config/":
+//
+//	kv, err := cache.NewKV(context.TODO(), cli.KV, cli.Watcher, 1000, "config/")
+//	if err != nil {
+//		// handle error!
+//	}
+//	defer kv.Close()
+//
+// A Get of a single key under a cached prefix, without options, is sent to
+// the cluster the first time; later Gets of the key are answered from the
+// cache until a watch event or a write through kv changes the key:
+//
+//	kv.Get(context.TODO(), "config/abc") // sent to the cluster
+//	kv.Get(context.TODO(), "config/abc") // answered from the cache
+//
+// All other requests go to the wrapped KV. Register kv with prometheus to
+// export the number of cache hits and misses.
+package cache
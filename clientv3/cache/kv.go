@@ -0,0 +1,313 @@
+// Copyright 2016 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"container/list"
+	"strings"
+	"sync"
+
+	"github.com/coreos/etcd/clientv3"
+	pb "github.com/coreos/etcd/etcdserver/etcdserverpb"
+	"github.com/coreos/etcd/mvcc/mvccpb"
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/net/context"
+)
+
+// DefaultSize is the number of keys cached when NewKV is given a size of
+// zero or less.
+const DefaultSize = 1024
+
+// KV is a clientv3.KV that caches single-key Gets under a set of prefixes.
+// Entries are dropped when a watch on their prefix reports a change, when
+// the key is written through KV, or when the cache is full and the entry
+// is the least recently used one. If a watch fails, the cache is cleared
+// and every later request goes to the wrapped KV.
+type KV struct {
+	clientv3.KV
+
+	size     int
+	prefixes []string
+	gets     *prometheus.CounterVec
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	lru     *list.List // most recently used first
+	// rev is the highest revision of the changes applied to the cache;
+	// reads answered at an earlier revision are not cached.
+	rev    int64
+	closed bool
+}
+
+type entry struct {
+	key    string
+	header *pb.ResponseHeader
+	kv     *mvccpb.KeyValue // nil if the key does not exist
+}
+
+// NewKV wraps kv with a cache of up to size keys under the given prefixes;
+// an empty prefix caches every key. The prefixes are watched with w until
+// Close is called.
+func NewKV(ctx context.Context, kv clientv3.KV, w clientv3.Watcher, size int, prefixes ...string) (*KV, error) {
+	if size <= 0 {
+		size = DefaultSize
+	}
+	c := &KV{
+		KV:       kv,
+		size:     size,
+		prefixes: prefixes,
+		gets: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: "etcd",
+				Subsystem: "client",
+				Name:      "cache_gets_total",
+				Help:      "Counter of cacheable Gets, by whether they were answered from the cache.",
+			}, []string{"result"}),
+		entries: make(map[string]*list.Element),
+		lru:     list.New(),
+	}
+	if len(prefixes) == 0 {
+		c.closed = true
+		return c, nil
+	}
+
+	// watch from the current revision so no change after it is missed
+	resp, err := kv.Get(ctx, prefixes[0], clientv3.WithPrefix(), clientv3.WithCountOnly())
+	if err != nil {
+		return nil, err
+	}
+	c.rev = resp.Header.Revision
+
+	wctx, cancel := context.WithCancel(context.Background())
+	c.cancel = cancel
+	for _, pfx := range prefixes {
+		wch := w.Watch(wctx, pfx, clientv3.WithPrefix(), clientv3.WithRev(c.rev+1))
+		c.wg.Add(1)
+		go c.watch(wch)
+	}
+	return c, nil
+}
+
+// Close stops watching the cached prefixes and clears the cache.
+func (c *KV) Close() error {
+	if c.cancel != nil {
+		c.cancel()
+	}
+	c.wg.Wait()
+	return nil
+}
+
+func (c *KV) watch(wch clientv3.WatchChan) {
+	defer c.wg.Done()
+	for wr := range wch {
+		if wr.Err() != nil {
+			break
+		}
+		c.mu.Lock()
+		for _, ev := range wr.Events {
+			c.remove(string(ev.Kv.Key))
+			if ev.Kv.ModRevision > c.rev {
+				c.rev = ev.Kv.ModRevision
+			}
+		}
+		c.mu.Unlock()
+	}
+	// changes may be missed from now on
+	c.mu.Lock()
+	c.closed = true
+	c.entries = make(map[string]*list.Element)
+	c.lru.Init()
+	c.mu.Unlock()
+}
+
+func (c *KV) Get(ctx context.Context, key string, opts ...clientv3.OpOption) (*clientv3.GetResponse, error) {
+	if len(opts) != 0 || !c.cached(key) {
+		return c.KV.Get(ctx, key, opts...)
+	}
+	if resp := c.lookup(key); resp != nil {
+		c.gets.WithLabelValues("hit").Inc()
+		return resp, nil
+	}
+	c.gets.WithLabelValues("miss").Inc()
+	resp, err := c.KV.Get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	c.insert(key, resp)
+	return resp, nil
+}
+
+func (c *KV) Put(ctx context.Context, key, val string, opts ...clientv3.OpOption) (*clientv3.PutResponse, error) {
+	resp, err := c.KV.Put(ctx, key, val, opts...)
+	if err != nil {
+		return nil, err
+	}
+	c.invalidate(key, "", resp.Header)
+	return resp, nil
+}
+
+func (c *KV) Delete(ctx context.Context, key string, opts ...clientv3.OpOption) (*clientv3.DeleteResponse, error) {
+	resp, err := c.KV.Delete(ctx, key, opts...)
+	if err != nil {
+		return nil, err
+	}
+	op := clientv3.OpDelete(key, opts...)
+	c.invalidate(key, string(op.RangeBytes()), resp.Header)
+	return resp, nil
+}
+
+func (c *KV) Do(ctx context.Context, op clientv3.Op) (clientv3.OpResponse, error) {
+	resp, err := c.KV.Do(ctx, op)
+	if err != nil {
+		return resp, err
+	}
+	if op.IsPut() || op.IsDelete() {
+		c.invalidate(string(op.KeyBytes()), string(op.RangeBytes()), resp.Header())
+	}
+	return resp, nil
+}
+
+func (c *KV) Txn(ctx context.Context) clientv3.Txn {
+	return &txnCache{Txn: c.KV.Txn(ctx), kv: c}
+}
+
+// Describe implements prometheus.Collector.
+func (c *KV) Describe(ch chan<- *prometheus.Desc) { c.gets.Describe(ch) }
+
+// Collect implements prometheus.Collector.
+func (c *KV) Collect(ch chan<- prometheus.Metric) { c.gets.Collect(ch) }
+
+// cached returns true if key is under one of the cached prefixes.
+func (c *KV) cached(key string) bool {
+	for _, pfx := range c.prefixes {
+		if strings.HasPrefix(key, pfx) {
+			return true
+		}
+	}
+	return false
+}
+
+// lookup returns a copy of the cached response for key, or nil on a miss.
+func (c *KV) lookup(key string) *clientv3.GetResponse {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	el, ok := c.entries[key]
+	if !ok {
+		return nil
+	}
+	c.lru.MoveToFront(el)
+	e := el.Value.(*entry)
+	h := *e.header
+	resp := &clientv3.GetResponse{Header: &h}
+	if e.kv != nil {
+		kv := *e.kv
+		resp.Kvs, resp.Count = []*mvccpb.KeyValue{&kv}, 1
+	}
+	return resp
+}
+
+// insert caches the response of a Get of key, unless a change applied to
+// the cache since may have made it stale.
+func (c *KV) insert(key string, resp *clientv3.GetResponse) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.closed || resp.Header.Revision < c.rev {
+		return
+	}
+	c.remove(key)
+	e := &entry{key: key, header: resp.Header}
+	if len(resp.Kvs) != 0 {
+		kv := *resp.Kvs[0]
+		e.kv = &kv
+	}
+	c.entries[key] = c.lru.PushFront(e)
+	for c.lru.Len() > c.size {
+		c.remove(c.lru.Back().Value.(*entry).key)
+	}
+}
+
+// invalidate drops the cached keys in [key, end) after a write through the
+// cache; an empty end drops only key, an end of "\x00" every key from key.
+func (c *KV) invalidate(key, end string, h *pb.ResponseHeader) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	switch end {
+	case "":
+		c.remove(key)
+	case "\x00":
+		for k := range c.entries {
+			if k >= key {
+				c.remove(k)
+			}
+		}
+	default:
+		for k := range c.entries {
+			if k >= key && k < end {
+				c.remove(k)
+			}
+		}
+	}
+	// reads issued before the write must not bring back the old value
+	if h != nil && h.Revision > c.rev {
+		c.rev = h.Revision
+	}
+}
+
+// remove drops key from the cache; c.mu must be held.
+func (c *KV) remove(key string) {
+	if el, ok := c.entries[key]; ok {
+		c.lru.Remove(el)
+		delete(c.entries, key)
+	}
+}
+
+type txnCache struct {
+	clientv3.Txn
+	kv  *KV
+	ops []clientv3.Op
+}
+
+func (txn *txnCache) If(cs ...clientv3.Cmp) clientv3.Txn {
+	txn.Txn = txn.Txn.If(cs...)
+	return txn
+}
+
+func (txn *txnCache) Then(ops ...clientv3.Op) clientv3.Txn {
+	txn.Txn = txn.Txn.Then(ops...)
+	txn.ops = append(txn.ops, ops...)
+	return txn
+}
+
+func (txn *txnCache) Else(ops ...clientv3.Op) clientv3.Txn {
+	txn.Txn = txn.Txn.Else(ops...)
+	txn.ops = append(txn.ops, ops...)
+	return txn
+}
+
+func (txn *txnCache) Commit() (*clientv3.TxnResponse, error) {
+	resp, err := txn.Txn.Commit()
+	if err != nil {
+		return nil, err
+	}
+	for _, op := range txn.ops {
+		if op.IsPut() || op.IsDelete() {
+			txn.kv.invalidate(string(op.KeyBytes()), string(op.RangeBytes()), resp.Header)
+		}
+	}
+	return resp, nil
+}
@@ -31,12 +31,15 @@ import (
 	"golang.org/x/net/context"
 	"golang.org/x/time/rate"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/metadata"
 )
 
 var (
 	ErrNoAvailableEndpoints = errors.New("etcdclient: no available endpoints")
+	// ErrClientClosed is returned by requests issued after Close.
+	ErrClientClosed = errors.New("etcdclient: client is closed")
 
 	// minConnRetryWait is the minimum time between reconnects to avoid flooding
 	minConnRetryWait = time.Second
@@ -57,6 +60,28 @@ type Client struct {
 	mu     sync.RWMutex // protects connection selection and error list
 	errors []error      // errors passed to retryConnection
 
+	// epMu protects cfg.Endpoints for readers not holding mu; writers
+	// hold both.
+	epMu sync.RWMutex
+
+	// reads holds the connections of reads spread by the ReadBalancer.
+	reads readConns
+
+	// breaker skips endpoints that keep failing to connect.
+	breaker *circuitBreaker
+
+	// retries bounds the retries of all requests of the client.
+	retries *retryBudget
+
+	// rev is the highest revision observed in KV responses; accessed
+	// atomically.
+	rev int64
+
+	// firstEndpoint is the index of the endpoint dialEndpointList tries
+	// first; it is the endpoint of the current connection until the
+	// connection fails.
+	firstEndpoint int
+
 	ctx    context.Context
 	cancel context.CancelFunc
 
@@ -114,6 +139,7 @@ func (c *Client) Close() error {
 	c.connStartRetry(nil)
 	c.Watcher.Close()
 	c.Lease.Close()
+	c.closeReadConns()
 	<-connc
 	c.mu.Lock()
 	if c.lastConnErr != c.ctx.Err() {
@@ -128,7 +154,11 @@ func (c *Client) Close() error {
 func (c *Client) Ctx() context.Context { return c.ctx }
 
 // Endpoints lists the registered endpoints for the client.
-func (c *Client) Endpoints() []string { return c.cfg.Endpoints }
+func (c *Client) Endpoints() []string {
+	c.epMu.RLock()
+	defer c.epMu.RUnlock()
+	return c.cfg.Endpoints
+}
 
 // Errors returns all errors that have been observed since called last.
 func (c *Client) Errors() (errs []error) {
@@ -155,8 +185,30 @@ func (cred authTokenCredential) GetRequestMetadata(ctx context.Context, s ...str
 
 // Dial establishes a connection for a given endpoint using the client's config
 func (c *Client) Dial(endpoint string) (*grpc.ClientConn, error) {
+	return c.dial(c.ctx, endpoint)
+}
+
+// dial establishes a connection to endpoint like Dial, but gives up once
+// ctx is done as well as after the DialTimeout or on client Close.
+func (c *Client) dial(ctx context.Context, endpoint string) (*grpc.ClientConn, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	donec := make(chan struct{})
+	defer func() {
+		cancel()
+		<-donec
+	}()
+	go func() {
+		defer close(donec)
+		select {
+		case <-c.ctx.Done():
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	// the connection is awaited with waitReady rather than WithBlock,
+	// which can't be canceled
 	opts := []grpc.DialOption{
-		grpc.WithBlock(),
 		grpc.WithTimeout(c.cfg.DialTimeout),
 	}
 
@@ -180,13 +232,20 @@ func (c *Client) Dial(endpoint string) (*grpc.ClientConn, error) {
 		// strip scheme:// prefix since grpc dials by host
 		endpoint = url.Host
 	}
+	if creds != nil && c.cfg.TLS != nil {
+		// the credentials keep the server name of the first endpoint they
+		// verify, so give each endpoint its own
+		tlsCreds := credentials.NewTLS(tlsConfigFor(c.cfg.TLS, endpoint))
+		creds = &tlsCreds
+	}
 	f := func(a string, t time.Duration) (net.Conn, error) {
 		select {
-		case <-c.ctx.Done():
-			return nil, c.ctx.Err()
+		case <-ctx.Done():
+			return nil, ctx.Err()
 		default:
 		}
-		return net.DialTimeout(proto, a, t)
+		d := net.Dialer{Timeout: t, KeepAlive: c.cfg.DialKeepAliveTime}
+		return d.Dial(proto, a)
 	}
 	opts = append(opts, grpc.WithDialer(f))
 
@@ -202,8 +261,11 @@ func (c *Client) Dial(endpoint string) (*grpc.ClientConn, error) {
 			return nil, err
 		}
 		defer auth.close()
+		if err = waitReady(ctx, auth.conn); err != nil {
+			return nil, err
+		}
 
-		resp, err := auth.authenticate(c.ctx, c.Username, c.Password)
+		resp, err := auth.authenticate(ctx, c.Username, c.Password)
 		if err != nil {
 			return nil, err
 		}
@@ -215,9 +277,48 @@ func (c *Client) Dial(endpoint string) (*grpc.ClientConn, error) {
 	if err != nil {
 		return nil, err
 	}
+	if err = waitReady(ctx, conn); err != nil {
+		conn.Close()
+		return nil, err
+	}
 	return conn, nil
 }
 
+// waitReady waits until conn is ready. It fails with ErrClientConnTimeout
+// once grpc gives up on the connection after the DialTimeout, or with the
+// context error if ctx is done first.
+func waitReady(ctx context.Context, conn *grpc.ClientConn) error {
+	for {
+		st, err := conn.State()
+		if err != nil {
+			return err
+		}
+		switch st {
+		case grpc.Ready:
+			return nil
+		case grpc.Shutdown:
+			return grpc.ErrClientConnTimeout
+		}
+		if _, err = conn.WaitForStateChange(ctx, st); err != nil {
+			return err
+		}
+	}
+}
+
+// tlsConfigFor returns a copy of cfg that verifies the certificate of the
+// given endpoint's host, unless cfg already names the server to verify.
+func tlsConfigFor(cfg *tls.Config, endpoint string) *tls.Config {
+	cp := cloneTLSConfig(cfg)
+	if cp.ServerName == "" {
+		host, _, err := net.SplitHostPort(endpoint)
+		if err != nil {
+			host = endpoint
+		}
+		cp.ServerName = host
+	}
+	return cp
+}
+
 // WithRequireLeader requires client requests to only succeed
 // when the cluster has a leader.
 func WithRequireLeader(ctx context.Context) context.Context {
@@ -225,6 +326,36 @@ func WithRequireLeader(ctx context.Context) context.Context {
 	return metadata.NewContext(ctx, md)
 }
 
+// withMetadata attaches the metadata given by the client's
+// MetadataFromContext for ctx to the outgoing metadata already in ctx.
+func (c *Client) withMetadata(ctx context.Context) context.Context {
+	f := c.cfg.MetadataFromContext
+	if f == nil {
+		return ctx
+	}
+	md := f(ctx)
+	if len(md) == 0 {
+		return ctx
+	}
+	if omd, ok := metadata.FromContext(ctx); ok {
+		md = md.Copy()
+		for k, v := range omd {
+			md[k] = append(md[k], v...)
+		}
+	}
+	return metadata.NewContext(ctx, md)
+}
+
+// requiresLeader returns true if ctx was made by WithRequireLeader.
+func requiresLeader(ctx context.Context) bool {
+	md, ok := metadata.FromContext(ctx)
+	if !ok {
+		return false
+	}
+	ks := md[rpctypes.MetadataRequireLeaderKey]
+	return len(ks) > 0 && ks[0] == rpctypes.MetadataHasLeader
+}
+
 func newClient(cfg *Config) (*Client, error) {
 	if cfg == nil {
 		cfg = &Config{RetryDialer: dialEndpointList}
@@ -237,18 +368,23 @@ func newClient(cfg *Config) (*Client, error) {
 
 	// use a temporary skeleton client to bootstrap first connection
 	ctx, cancel := context.WithCancel(context.TODO())
-	conn, err := cfg.RetryDialer(&Client{cfg: *cfg, creds: creds, ctx: ctx, Username: cfg.Username, Password: cfg.Password})
+	breaker := newCircuitBreaker(cfg.BreakerThreshold, cfg.BreakerCooldown)
+	skel := &Client{cfg: *cfg, creds: creds, breaker: breaker, ctx: ctx, Username: cfg.Username, Password: cfg.Password}
+	conn, err := cfg.RetryDialer(skel)
 	if err != nil {
 		return nil, err
 	}
 	client := &Client{
-		conn:     conn,
-		cfg:      *cfg,
-		creds:    creds,
-		ctx:      ctx,
-		cancel:   cancel,
-		reconnc:  make(chan error, 1),
-		newconnc: make(chan struct{}),
+		conn:          conn,
+		cfg:           *cfg,
+		creds:         creds,
+		breaker:       breaker,
+		retries:       newRetryBudget(cfg.RetryBudgetRatio, cfg.RetryBudgetMinPerSecond),
+		firstEndpoint: skel.firstEndpoint,
+		ctx:           ctx,
+		cancel:        cancel,
+		reconnc:       make(chan error, 1),
+		newconnc:      make(chan struct{}),
 	}
 
 	if cfg.Username != "" && cfg.Password != "" {
@@ -257,6 +393,12 @@ func newClient(cfg *Config) (*Client, error) {
 	}
 
 	go client.connMonitor()
+	if cfg.HealthCheckInterval > 0 {
+		go client.healthMonitor()
+	}
+	if cfg.AutoSyncInterval > 0 {
+		go client.autoSync()
+	}
 
 	client.Cluster = NewCluster(client)
 	client.KV = NewKV(client)
@@ -274,6 +416,21 @@ func newClient(cfg *Config) (*Client, error) {
 	return client, nil
 }
 
+// newDetachedClient returns a client without connections, which backs the
+// KV, Lease and Watcher given their remote by the FromClient constructors.
+// Its requests follow cfg, except that they are never spread over
+// connections by a ReadBalancer.
+func newDetachedClient(cfg Config) *Client {
+	cfg.ReadBalancer = nil
+	ctx, cancel := context.WithCancel(context.TODO())
+	return &Client{
+		cfg:     cfg,
+		retries: newRetryBudget(cfg.RetryBudgetRatio, cfg.RetryBudgetMinPerSecond),
+		ctx:     ctx,
+		cancel:  cancel,
+	}
+}
+
 // ActiveConnection returns the current in-use connection
 func (c *Client) ActiveConnection() *grpc.ClientConn {
 	c.mu.RLock()
@@ -281,12 +438,66 @@ func (c *Client) ActiveConnection() *grpc.ClientConn {
 	return c.conn
 }
 
-// retryConnection establishes a new connection
+// ConnState reports the connectivity state of the active connection and
+// the endpoint it was dialed to, for diagnostics. While the client has no
+// connection, after a failed reconnect, the state is TransientFailure, or
+// Shutdown once the client is closed, and the endpoint is empty.
+func (c *Client) ConnState() (grpc.ConnectivityState, string) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if c.conn == nil {
+		if c.cancel == nil {
+			return grpc.Shutdown, ""
+		}
+		return grpc.TransientFailure, ""
+	}
+	st, err := c.conn.State()
+	if err != nil {
+		st = grpc.Shutdown
+	}
+	return st, c.endpoint()
+}
+
+// curEndpoint returns the endpoint of the active connection, or the one the
+// next reconnect starts from.
+func (c *Client) curEndpoint() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.endpoint()
+}
+
+// endpoint is curEndpoint with c.mu held.
+func (c *Client) endpoint() string {
+	if c.firstEndpoint < len(c.cfg.Endpoints) {
+		return c.cfg.Endpoints[c.firstEndpoint]
+	}
+	return ""
+}
+
+// retryConnection establishes a new connection. The reconnect is shared by
+// all requests, so its dial is bounded by the client's context, which Close
+// cancels, rather than by theirs; a request waiting on it in connWait
+// returns as soon as its own context is done.
 func (c *Client) retryConnection(err error) (newConn *grpc.ClientConn, dialErr error) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 	if err != nil {
 		c.errors = append(c.errors, err)
+		// the current endpoint failed; start dialing from the next
+		// one so a down member is tried last
+		if n := len(c.cfg.Endpoints); n > 0 {
+			ep := c.cfg.Endpoints[c.firstEndpoint]
+			closing := c.ctx != nil && c.ctx.Err() != nil
+			if !closing && !isServerErr(err) {
+				// only a failed connection counts against the endpoint;
+				// a member refusing requests (e.g., no leader) is healthy
+				c.breaker.failure(ep)
+			}
+			if c.cfg.ReadBalancer != nil && !closing {
+				c.readFailed(ep, nil)
+			}
+			c.firstEndpoint = (c.firstEndpoint + 1) % n
+		}
 	}
 	if c.conn != nil {
 		c.conn.Close()
@@ -319,12 +530,27 @@ func (c *Client) connStartRetry(err error) {
 	}
 }
 
-// connWait waits for a reconnect to be processed
-func (c *Client) connWait(ctx context.Context, err error) (*grpc.ClientConn, error) {
+// connWait waits for a reconnect to be processed. If the connection failed
+// is no longer active, another request already reconnected, so the active
+// connection is returned without reconnecting again.
+func (c *Client) connWait(ctx context.Context, failed *grpc.ClientConn, err error) (*grpc.ClientConn, error) {
 	c.mu.Lock()
+	if c.cancel == nil {
+		c.mu.Unlock()
+		return nil, ErrClientClosed
+	}
+	if failed != nil && c.conn != nil && c.conn != failed {
+		conn := c.conn
+		c.mu.Unlock()
+		return conn, nil
+	}
 	ch := c.newconnc
+	// schedule under the lock so the reconnect cannot complete in between
+	select {
+	case c.reconnc <- err:
+	default:
+	}
 	c.mu.Unlock()
-	c.connStartRetry(err)
 	select {
 	case <-ctx.Done():
 		return nil, ctx.Err()
@@ -332,6 +558,9 @@ func (c *Client) connWait(ctx context.Context, err error) (*grpc.ClientConn, err
 	}
 	c.mu.Lock()
 	defer c.mu.Unlock()
+	if c.cancel == nil {
+		return nil, ErrClientClosed
+	}
 	return c.conn, c.lastConnErr
 }
 
@@ -348,13 +577,18 @@ func (c *Client) connMonitor() {
 	}()
 
 	limiter := rate.NewLimiter(rate.Every(minConnRetryWait), 1)
+	attempt := 0
 	for limiter.Wait(c.ctx) == nil {
 		select {
 		case err = <-c.reconnc:
 		case <-c.ctx.Done():
 			return
 		}
-		conn, connErr := c.retryConnection(err)
+		attempt++
+		conn, connErr := c.logRetryConnection(err, attempt)
+		if connErr == nil {
+			attempt = 0
+		}
 		c.mu.Lock()
 		c.lastConnErr = connErr
 		c.conn = conn
@@ -365,24 +599,101 @@ func (c *Client) connMonitor() {
 	}
 }
 
-// dialEndpointList attempts to connect to each endpoint in order until a
-// connection is established.
+// dialEndpointList attempts to connect to each endpoint in order, starting
+// from the client's first endpoint, until a connection is established.
+// Endpoints held open by the circuit breaker are skipped, unless all of
+// them are, so the client still reconnects once the cluster recovers.
 func dialEndpointList(c *Client) (*grpc.ClientConn, error) {
-	var err error
-	for _, ep := range c.Endpoints() {
-		conn, curErr := c.Dial(ep)
+	err := ErrNoAvailableEndpoints
+	eps := c.Endpoints()
+	var idxs []int
+	for i := range eps {
+		if idx := (c.firstEndpoint + i) % len(eps); c.breaker.allow(eps[idx]) {
+			idxs = append(idxs, idx)
+		}
+	}
+	if len(idxs) == 0 {
+		// every endpoint is open; try them all anyway
+		for i := range eps {
+			idxs = append(idxs, (c.firstEndpoint+i)%len(eps))
+		}
+	}
+	for _, idx := range idxs {
+		conn, curErr := c.Dial(eps[idx])
 		if curErr != nil {
+			c.breaker.failure(eps[idx])
 			err = curErr
 		} else {
+			c.breaker.success(eps[idx])
+			c.firstEndpoint = idx
 			return conn, nil
 		}
 	}
 	return nil, err
 }
 
+// withRequestTimeout returns a context for a single request attempt,
+// bounded by the client's RequestTimeout if set.
+func (c *Client) withRequestTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if c.cfg.RequestTimeout <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, c.cfg.RequestTimeout)
+}
+
+// toErr converts err to a client error, preferring the context error when
+// ctx is done so callers see context.Canceled or context.DeadlineExceeded.
+func toErr(ctx context.Context, err error) error {
+	if err == nil {
+		return nil
+	}
+	if cerr := ctx.Err(); cerr != nil {
+		return cerr
+	}
+	return rpctypes.Error(err)
+}
+
 // isHaltErr returns true if the given error and context indicate no forward
 // progress can be made, even after reconnecting.
 func isHaltErr(ctx context.Context, err error) bool {
-	isRPCError := strings.HasPrefix(grpc.ErrorDesc(err), "etcdserver: ")
-	return isRPCError || ctx.Err() != nil
+	if ctx != nil && ctx.Err() != nil {
+		return true
+	}
+	if err == nil {
+		return false
+	}
+	return !isRetryableErr(err)
+}
+
+// isRetryableErr returns true if the request failing with err may succeed
+// when issued again, possibly on another member. Errors that reflect the
+// request itself (InvalidArgument, FailedPrecondition, OutOfRange) and
+// other errors returned by the etcd server are terminal; any other error,
+// including a stream ending with io.EOF, is retried.
+func isRetryableErr(err error) bool {
+	if grpc.ErrorDesc(err) == grpc.ErrClientConnClosing.Error() {
+		// the connection was closed for a reconnect while in use
+		return true
+	}
+	switch grpc.Code(err) {
+	case codes.Unavailable:
+		// the member can't serve requests right now (e.g., can't connect,
+		// lost leader); another member or a later attempt may
+		return true
+	case codes.DeadlineExceeded:
+		// the server timed out the request; the caller's context, if done,
+		// is checked by isHaltErr first
+		return true
+	case codes.Internal:
+		// the connection failed mid-request (e.g., corrupted frame)
+		return true
+	case codes.Unauthenticated:
+		// the auth token is no longer valid; reconnecting authenticates
+		// again for a new one
+		return true
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		return false
+	}
+	// the etcd server rejected the request
+	return !strings.HasPrefix(grpc.ErrorDesc(err), "etcdserver: ")
 }
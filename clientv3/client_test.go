@@ -15,12 +15,18 @@
 package clientv3
 
 import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
 	"fmt"
+	"io"
 	"testing"
 	"time"
 
+	"github.com/coreos/etcd/etcdserver/api/v3rpc/rpctypes"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 )
 
 func TestDialTimeout(t *testing.T) {
@@ -68,3 +74,175 @@ func TestIsHaltErr(t *testing.T) {
 		t.Errorf("cancel on context should be Halted")
 	}
 }
+
+// TestIsRetryableErr documents which errors a request is retried on.
+func TestIsRetryableErr(t *testing.T) {
+	tests := []struct {
+		err error
+		w   bool
+	}{
+		{grpc.Errorf(codes.Unavailable, "transport is closing"), true},
+		{grpc.Errorf(codes.DeadlineExceeded, "timed out"), true},
+		{grpc.Errorf(codes.Internal, "transport: corrupted frame"), true},
+		{grpc.Errorf(codes.Unknown, "%v", grpc.ErrClientConnClosing), true},
+		{rpctypes.ErrGRPCNoLeader, true},
+		{rpctypes.ErrGRPCNotCapable, true},
+		{rpctypes.ErrGRPCInvalidAuthToken, true},
+		{rpctypes.ErrGRPCTimeoutLeaderTransfer, true},
+		// errors not from the etcd server, e.g., a stream ending cleanly
+		{io.EOF, true},
+		{grpc.Errorf(codes.Unknown, "unknown"), true},
+		{grpc.Errorf(codes.Aborted, "aborted"), true},
+
+		{grpc.Errorf(codes.InvalidArgument, "bad request"), false},
+		{grpc.Errorf(codes.FailedPrecondition, "precondition"), false},
+		{grpc.Errorf(codes.OutOfRange, "out of range"), false},
+		{rpctypes.ErrGRPCCompacted, false},
+		{rpctypes.ErrGRPCEmptyKey, false},
+		{rpctypes.ErrGRPCPermissionDenied, false},
+		{rpctypes.ErrGRPCLeaseNotFound, false},
+		{rpctypes.ErrGRPCNoSpace, false},
+		{fmt.Errorf("etcdserver: some etcdserver error"), false},
+	}
+	for i, tt := range tests {
+		if g := isRetryableErr(tt.err); g != tt.w {
+			t.Errorf("#%d: isRetryableErr(%v) = %v, want %v", i, tt.err, g, tt.w)
+		}
+		// a done context halts regardless of the error
+		ctx, cancel := context.WithCancel(context.TODO())
+		if g := isHaltErr(ctx, tt.err); g == tt.w {
+			t.Errorf("#%d: isHaltErr(%v) = %v, want %v", i, tt.err, g, !tt.w)
+		}
+		cancel()
+		if !isHaltErr(ctx, tt.err) {
+			t.Errorf("#%d: isHaltErr(canceled, %v) = false, want true", i, tt.err)
+		}
+	}
+}
+
+func TestDialCancel(t *testing.T) {
+	// nothing listens on the port, so grpc keeps redialing without a
+	// DialTimeout
+	ep := "127.0.0.1:1"
+
+	c := &Client{ctx: context.Background()}
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	donec := make(chan error, 1)
+	go func() {
+		_, err := c.dial(ctx, ep)
+		donec <- err
+	}()
+	select {
+	case err := <-donec:
+		if err != context.DeadlineExceeded {
+			t.Errorf("err = %v, want %v", err, context.DeadlineExceeded)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("dial not canceled with its context")
+	}
+
+	// closing the client cancels its dials
+	cctx, ccancel := context.WithCancel(context.Background())
+	c = &Client{ctx: cctx}
+	go func() {
+		_, err := c.Dial(ep)
+		donec <- err
+	}()
+	time.Sleep(100 * time.Millisecond)
+	ccancel()
+	select {
+	case err := <-donec:
+		if err != context.Canceled {
+			t.Errorf("err = %v, want %v", err, context.Canceled)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("dial not canceled on client close")
+	}
+}
+
+func TestRetryConnectionRotatesEndpoint(t *testing.T) {
+	var firsts []int
+	cfg := Config{
+		Endpoints: []string{"a", "b", "c"},
+		RetryDialer: func(c *Client) (*grpc.ClientConn, error) {
+			firsts = append(firsts, c.firstEndpoint)
+			return nil, nil
+		},
+	}
+	c := &Client{cfg: cfg, cancel: func() {}}
+
+	errs := []error{errors.New("fail"), nil, errors.New("fail"), errors.New("fail")}
+	for _, err := range errs {
+		c.retryConnection(err)
+	}
+	// stay on the endpoint unless the connection failed
+	if w := []int{1, 1, 2, 0}; fmt.Sprint(firsts) != fmt.Sprint(w) {
+		t.Errorf("first endpoints = %v, want %v", firsts, w)
+	}
+}
+
+func TestSetEndpoints(t *testing.T) {
+	c := &Client{cfg: Config{Endpoints: []string{"a", "b", "c"}}, firstEndpoint: 1}
+
+	tests := []struct {
+		eps []string
+
+		wfirst int
+	}{
+		// reconnects start from the current endpoint
+		{[]string{"d", "b"}, 1},
+		{[]string{"b", "c", "d"}, 0},
+		// or the first one if it was removed
+		{[]string{"c", "d"}, 0},
+	}
+	for i, tt := range tests {
+		c.SetEndpoints(tt.eps...)
+		if eps := c.Endpoints(); fmt.Sprint(eps) != fmt.Sprint(tt.eps) {
+			t.Errorf("#%d: endpoints = %v, want %v", i, eps, tt.eps)
+		}
+		if c.firstEndpoint != tt.wfirst {
+			t.Errorf("#%d: first endpoint = %d, want %d", i, c.firstEndpoint, tt.wfirst)
+		}
+	}
+}
+
+func TestTLSConfigFor(t *testing.T) {
+	tests := []struct {
+		cfg      *tls.Config
+		endpoint string
+
+		wname string
+	}{
+		{&tls.Config{}, "host1:2379", "host1"},
+		{&tls.Config{}, "127.0.0.1:2379", "127.0.0.1"},
+		{&tls.Config{}, "[::1]:2379", "::1"},
+		{&tls.Config{}, "host1", "host1"},
+		{&tls.Config{ServerName: "etcd"}, "host1:2379", "etcd"},
+	}
+	for i, tt := range tests {
+		cfg := tlsConfigFor(tt.cfg, tt.endpoint)
+		if cfg.ServerName != tt.wname {
+			t.Errorf("#%d: server name = %q, want %q", i, cfg.ServerName, tt.wname)
+		}
+		if cfg == tt.cfg {
+			t.Errorf("#%d: expected a copy of the config", i)
+		}
+	}
+
+	// each endpoint verifies its own name
+	base := &tls.Config{}
+	if a, b := tlsConfigFor(base, "host1:2379"), tlsConfigFor(base, "host2:2379"); a.ServerName == b.ServerName {
+		t.Errorf("expected distinct server names, got %q", a.ServerName)
+	}
+	if base.ServerName != "" {
+		t.Errorf("base config modified, server name = %q", base.ServerName)
+	}
+
+	// the copy keeps the settings of the original
+	pool := x509.NewCertPool()
+	base = &tls.Config{RootCAs: pool, InsecureSkipVerify: true, MinVersion: tls.VersionTLS12}
+	if cfg := tlsConfigFor(base, "host1:2379"); cfg.RootCAs != pool || !cfg.InsecureSkipVerify || cfg.MinVersion != tls.VersionTLS12 {
+		t.Errorf("expected settings copied, got %+v", cfg)
+	}
+}
@@ -33,13 +33,16 @@ type Cluster interface {
 	// MemberList lists the current cluster membership.
 	MemberList(ctx context.Context) (*MemberListResponse, error)
 
-	// MemberAdd adds a new member into the cluster.
+	// MemberAdd adds a new member into the cluster. The response holds the
+	// membership after the change.
 	MemberAdd(ctx context.Context, peerAddrs []string) (*MemberAddResponse, error)
 
-	// MemberRemove removes an existing member from the cluster.
+	// MemberRemove removes an existing member from the cluster. The response
+	// holds the membership after the change.
 	MemberRemove(ctx context.Context, id uint64) (*MemberRemoveResponse, error)
 
-	// MemberUpdate updates the peer addresses of the member.
+	// MemberUpdate updates the peer addresses of the member. The response
+	// holds the membership after the change.
 	MemberUpdate(ctx context.Context, id uint64, peerAddrs []string) (*MemberUpdateResponse, error)
 }
 
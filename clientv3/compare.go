@@ -30,12 +30,19 @@ const (
 
 type Cmp pb.Compare
 
+// Compare builds a comparison of the target cmp against v. The result
+// is one of "=", "!=", "<" or ">". The value v must be a string when
+// comparing Value, and an int64 (or int) when comparing Version,
+// CreateRevision or ModRevision; a mismatched value makes the Commit
+// of any transaction using the comparison fail.
 func Compare(cmp Cmp, result string, v interface{}) Cmp {
 	var r pb.Compare_CompareResult
 
 	switch result {
 	case "=":
 		r = pb.Compare_EQUAL
+	case "!=":
+		r = pb.Compare_NOT_EQUAL
 	case ">":
 		r = pb.Compare_GREATER
 	case "<":
@@ -47,45 +54,53 @@ func Compare(cmp Cmp, result string, v interface{}) Cmp {
 	cmp.Result = r
 	switch cmp.Target {
 	case pb.Compare_VALUE:
-		val, ok := v.(string)
-		if !ok {
-			panic("bad compare value")
+		if val, ok := v.(string); ok {
+			cmp.TargetUnion = &pb.Compare_Value{Value: []byte(val)}
 		}
-		cmp.TargetUnion = &pb.Compare_Value{Value: []byte(val)}
 	case pb.Compare_VERSION:
-		cmp.TargetUnion = &pb.Compare_Version{Version: mustInt64(v)}
+		if val, ok := toInt64(v); ok {
+			cmp.TargetUnion = &pb.Compare_Version{Version: val}
+		}
 	case pb.Compare_CREATE:
-		cmp.TargetUnion = &pb.Compare_CreateRevision{CreateRevision: mustInt64(v)}
+		if val, ok := toInt64(v); ok {
+			cmp.TargetUnion = &pb.Compare_CreateRevision{CreateRevision: val}
+		}
 	case pb.Compare_MOD:
-		cmp.TargetUnion = &pb.Compare_ModRevision{ModRevision: mustInt64(v)}
+		if val, ok := toInt64(v); ok {
+			cmp.TargetUnion = &pb.Compare_ModRevision{ModRevision: val}
+		}
 	default:
 		panic("Unknown compare type")
 	}
 	return cmp
 }
 
+// Value compares the value of the given key.
 func Value(key string) Cmp {
 	return Cmp{Key: []byte(key), Target: pb.Compare_VALUE}
 }
 
+// Version compares the version of the given key.
 func Version(key string) Cmp {
 	return Cmp{Key: []byte(key), Target: pb.Compare_VERSION}
 }
 
+// CreateRevision compares the creation revision of the given key.
 func CreateRevision(key string) Cmp {
 	return Cmp{Key: []byte(key), Target: pb.Compare_CREATE}
 }
 
+// ModRevision compares the last modified revision of the given key.
 func ModRevision(key string) Cmp {
 	return Cmp{Key: []byte(key), Target: pb.Compare_MOD}
 }
 
-func mustInt64(val interface{}) int64 {
+func toInt64(val interface{}) (int64, bool) {
 	if v, ok := val.(int64); ok {
-		return v
+		return v, true
 	}
 	if v, ok := val.(int); ok {
-		return int64(v)
+		return int64(v), true
 	}
-	panic("bad value")
+	return 0, false
 }
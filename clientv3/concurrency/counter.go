@@ -0,0 +1,49 @@
+// Copyright 2016 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package concurrency
+
+import (
+	"fmt"
+	"strconv"
+
+	v3 "github.com/coreos/etcd/clientv3"
+	"golang.org/x/net/context"
+)
+
+// Increment atomically adds delta to the integer stored at key and returns
+// the new value; a negative delta decrements it. A missing or empty key
+// counts as 0. The update runs as a serializable STM transaction, so it is
+// retried until no other write to key conflicts with it. It fails without
+// writing if the key holds a value that is not an integer.
+func Increment(ctx context.Context, c *v3.Client, key string, delta int64) (int64, error) {
+	var n int64
+	_, err := NewSTMSerializable(ctx, c, func(stm STM) error {
+		n = 0
+		if v := stm.Get(key); v != "" {
+			cur, err := strconv.ParseInt(v, 10, 64)
+			if err != nil {
+				return fmt.Errorf("concurrency: counter %q holds non-integer value %q", key, v)
+			}
+			n = cur
+		}
+		n += delta
+		stm.Put(key, strconv.FormatInt(n, 10))
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return n, nil
+}
@@ -38,9 +38,11 @@ type Election struct {
 	leaderSession *Session
 }
 
-// NewElection returns a new election on a given key prefix.
+// NewElection returns a new election on a given key prefix. Candidates are
+// kept under pfx + "/", so elections whose names share a prefix (e.g.,
+// "a" and "ab") do not see each other's candidates.
 func NewElection(client *v3.Client, pfx string) *Election {
-	return &Election{client: client, keyPrefix: pfx}
+	return &Election{client: client, keyPrefix: pfx + "/"}
 }
 
 // Campaign puts a value as eligible for the election. It blocks until
@@ -51,7 +53,7 @@ func (e *Election) Campaign(ctx context.Context, val string) error {
 		return serr
 	}
 
-	k := fmt.Sprintf("%s/%x", e.keyPrefix, s.Lease())
+	k := fmt.Sprintf("%s%x", e.keyPrefix, s.Lease())
 	txn := e.client.Txn(ctx).If(v3.Compare(v3.CreateRevision(k), "=", 0))
 	txn = txn.Then(v3.OpPut(k, val, v3.WithLease(s.Lease())))
 	txn = txn.Else(v3.OpGet(k))
@@ -74,13 +76,8 @@ func (e *Election) Campaign(ctx context.Context, val string) error {
 
 	err = waitDeletes(ctx, e.client, e.keyPrefix, v3.WithPrefix(), v3.WithRev(e.leaderRev-1))
 	if err != nil {
-		// clean up in case of context cancel
-		select {
-		case <-ctx.Done():
-			e.Resign(e.client.Ctx())
-		default:
-			e.leaderSession = nil
-		}
+		// withdraw the candidacy so it does not hold up later campaigns
+		e.Resign(e.client.Ctx())
 		return err
 	}
 
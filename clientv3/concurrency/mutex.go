@@ -36,7 +36,8 @@ func NewMutex(client *v3.Client, pfx string) *Mutex {
 }
 
 // Lock locks the mutex with a cancellable context. If the context is cancelled
-// while trying to acquire the lock, the mutex tries to clean its stale lock entry.
+// or the wait otherwise fails while trying to acquire the lock, the mutex tries
+// to clean its stale lock entry so it does not hold up later waiters.
 func (m *Mutex) Lock(ctx context.Context) error {
 	s, serr := NewSession(m.client)
 	if serr != nil {
@@ -60,11 +61,9 @@ func (m *Mutex) Lock(ctx context.Context) error {
 
 	// wait for deletion revisions prior to myKey
 	err = waitDeletes(ctx, m.client, m.pfx, v3.WithPrefix(), v3.WithRev(m.myRev-1))
-	// release lock key if cancelled
-	select {
-	case <-ctx.Done():
+	// release lock key if the lock was not acquired
+	if err != nil {
 		m.Unlock(m.client.Ctx())
-	default:
 	}
 	return err
 }
@@ -15,6 +15,8 @@
 package concurrency
 
 import (
+	"errors"
+
 	v3 "github.com/coreos/etcd/clientv3"
 	"golang.org/x/net/context"
 )
@@ -36,24 +38,42 @@ type STM interface {
 	reset()
 }
 
+// ErrSTMConflict is returned when a transaction still conflicts with other
+// writes after the number of attempts given by WithMaxAttempts.
+var ErrSTMConflict = errors.New("stm: too many conflicts")
+
 // stmError safely passes STM errors through panic to the STM error channel.
 type stmError struct{ err error }
 
+type stmOptions struct {
+	maxAttempts int
+}
+
+// STMOption configures a software transactional memory transaction.
+type STMOption func(*stmOptions)
+
+// WithMaxAttempts limits how many times a transaction is applied and
+// committed before giving up on conflicts with ErrSTMConflict. Zero, the
+// default, retries until the transaction commits.
+func WithMaxAttempts(n int) STMOption {
+	return func(so *stmOptions) { so.maxAttempts = n }
+}
+
 // NewSTMRepeatable initiates new repeatable read transaction; reads within
 // the same transaction attempt always return the same data.
-func NewSTMRepeatable(ctx context.Context, c *v3.Client, apply func(STM) error) (*v3.TxnResponse, error) {
+func NewSTMRepeatable(ctx context.Context, c *v3.Client, apply func(STM) error, opts ...STMOption) (*v3.TxnResponse, error) {
 	s := &stm{client: c, ctx: ctx, getOpts: []v3.OpOption{v3.WithSerializable()}}
-	return runSTM(s, apply)
+	return runSTM(s, apply, opts)
 }
 
 // NewSTMSerializable initiates a new serialized transaction; reads within the
 // same transactiona attempt return data from the revision of the first read.
-func NewSTMSerializable(ctx context.Context, c *v3.Client, apply func(STM) error) (*v3.TxnResponse, error) {
+func NewSTMSerializable(ctx context.Context, c *v3.Client, apply func(STM) error, opts ...STMOption) (*v3.TxnResponse, error) {
 	s := &stmSerializable{
 		stm:      stm{client: c, ctx: ctx},
 		prefetch: make(map[string]*v3.GetResponse),
 	}
-	return runSTM(s, apply)
+	return runSTM(s, apply, opts)
 }
 
 type stmResponse struct {
@@ -61,7 +81,11 @@ type stmResponse struct {
 	err  error
 }
 
-func runSTM(s STM, apply func(STM) error) (*v3.TxnResponse, error) {
+func runSTM(s STM, apply func(STM) error, opts []STMOption) (*v3.TxnResponse, error) {
+	so := &stmOptions{}
+	for _, opt := range opts {
+		opt(so)
+	}
 	outc := make(chan stmResponse, 1)
 	go func() {
 		defer func() {
@@ -75,7 +99,7 @@ func runSTM(s STM, apply func(STM) error) (*v3.TxnResponse, error) {
 			}
 		}()
 		var out stmResponse
-		for {
+		for attempt := 1; ; attempt++ {
 			s.reset()
 			if out.err = apply(s); out.err != nil {
 				break
@@ -83,6 +107,10 @@ func runSTM(s STM, apply func(STM) error) (*v3.TxnResponse, error) {
 			if out.resp = s.commit(); out.resp != nil {
 				break
 			}
+			if so.maxAttempts > 0 && attempt >= so.maxAttempts {
+				out.err = ErrSTMConflict
+				break
+			}
 		}
 		outc <- out
 	}()
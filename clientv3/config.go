@@ -22,7 +22,9 @@ import (
 
 	"github.com/coreos/etcd/pkg/tlsutil"
 	"github.com/ghodss/yaml"
+	"golang.org/x/net/context"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 )
 
 // EndpointDialer is a policy for choosing which endpoint to dial next
@@ -38,13 +40,140 @@ type Config struct {
 	// DialTimeout is the timeout for failing to establish a connection.
 	DialTimeout time.Duration
 
+	// DialKeepAliveTime is the period of the TCP keep-alives sent on the
+	// client's connections, so an idle connection (e.g., of a quiet watch)
+	// is not dropped by a load balancer or NAT gateway in between. The
+	// vendored grpc has no keepalive support (no WithKeepaliveParams), so
+	// HTTP/2 pings cannot be configured; TCP keep-alives are used instead.
+	// They are sent whether or not the connection carries streams, and
+	// there is no keep-alive timeout to set: a peer that stops answering
+	// is detected by the operating system after several missed probes,
+	// and HealthCheckInterval detects it sooner. A period below the idle
+	// timeout of the network in between, such as 30 seconds, is reasonable.
+	// Zero, the default, dials as net.Dialer does by default; a negative
+	// value disables keep-alives.
+	DialKeepAliveTime time.Duration
+
+	// RequestTimeout bounds each attempt of a KV request, independent of
+	// the context passed by the caller; a sooner deadline on the caller's
+	// context still applies. Zero means no timeout.
+	//
+	// A read failing because the member has no leader is retried on the
+	// same member, with backoff, for up to RequestTimeout (5 seconds if
+	// zero) to wait out the election; a write is retried once.
+	RequestTimeout time.Duration
+
+	// RetryPolicy controls the retries of requests that failed because
+	// the connection broke. If nil, requests that are safe to retry are
+	// retried without backoff until their context is done.
+	RetryPolicy *RetryPolicy
+
+	// RetryRPCs additionally applies RetryPolicy to the KV requests (Range,
+	// Put, DeleteRange, Txn and Compact) that a member fails while still
+	// reachable (e.g., it has no leader), retrying them on the same member.
+	// Reads are retried on any such error; writes only if the error
+	// guarantees they were not applied. It has no effect without a
+	// RetryPolicy. Lease requests are not covered, since they already
+	// retry every failure on a new connection; neither are the Watch,
+	// Maintenance, Cluster and Auth APIs.
+	RetryRPCs bool
+
+	// FailFast makes KV requests return the error of their first failed
+	// attempt, leaving any retry to the caller, e.g., behind a proxy that
+	// is the only endpoint. They neither switch to a new connection nor
+	// retry, for lack of a leader, on the same member, and RetryRPCs does
+	// not apply to them.
+	FailFast bool
+
+	// RetryBudgetRatio bounds the retries of all requests of the client,
+	// however their RetryPolicy allows them, to this share of its
+	// successful requests, so retries cannot pile up on a failing cluster.
+	// A request that would exceed the budget fails with the error of its
+	// last attempt. Zero uses DefaultRetryBudgetRatio; a negative value
+	// disables the budget.
+	RetryBudgetRatio float64
+
+	// RetryBudgetMinPerSecond is the number of retries per second the
+	// client may make beyond RetryBudgetRatio, so requests are still
+	// retried while none succeed. Zero uses DefaultRetryBudgetMinPerSecond;
+	// a negative value allows none.
+	RetryBudgetMinPerSecond int
+
+	// ReadBalancer, if set, spreads serializable reads over the endpoints,
+	// each on its own connection. An endpoint that fails a read, or whose
+	// connection is replaced, is left out of the rotation for a while.
+	// Other requests stay on the active connection.
+	ReadBalancer ReadBalancer
+
+	// MaxStaleness, if positive, bounds how far a serializable read may
+	// lag. The client tracks the highest revision seen in the headers of
+	// its KV responses; a serializable Get answered at a revision more
+	// than MaxStaleness below it is issued again as a linearizable read.
+	// Revisions written by other clients are not seen, so staleness is
+	// only detected relative to what this client has observed.
+	MaxStaleness int64
+
+	// BreakerThreshold is the number of consecutive connection failures
+	// after which an endpoint is skipped by reconnects for BreakerCooldown.
+	// Only failures to dial or keep the connection count; errors returned
+	// by the member, such as no leader, do not. The count is shared by all
+	// requests of the client. If every endpoint is skipped, reconnects try
+	// them all anyway. Zero disables the circuit breaker.
+	BreakerThreshold int
+
+	// BreakerCooldown is how long an endpoint that tripped the breaker is
+	// skipped. Zero means 5 seconds.
+	BreakerCooldown time.Duration
+
+	// HealthCheckInterval is the interval between checks of the active
+	// connection. A connection that fails the check is replaced in the
+	// background, before requests are issued on it. Zero disables checks.
+	HealthCheckInterval time.Duration
+
+	// AutoSyncInterval is the interval between refreshes of Endpoints from
+	// the cluster member list, so members added later are used by
+	// reconnects and removed ones are dropped. Zero disables auto-sync;
+	// Client.Sync refreshes them on demand.
+	AutoSyncInterval time.Duration
+
 	// TLS holds the client secure credentials, if any.
 	TLS *tls.Config
 
 	// Logger is the logger used by client library.
 	Logger Logger
 
-	// Username is a username for authentication
+	// Metrics, if set, records prometheus metrics of KV requests.
+	Metrics *Metrics
+
+	// RequestHook, if set, is notified of each attempt of KV and Lease
+	// requests.
+	RequestHook RequestHook
+
+	// RedactHookKeys hides request keys from the RequestHook.
+	RedactHookKeys bool
+
+	// MetadataFromContext, if set, returns the gRPC metadata to send with
+	// a request issued with the given context, e.g., a request ID carried
+	// as a context value. It is called on each attempt of KV and Lease
+	// requests, so a retry on a new connection carries the metadata too.
+	// Watch and lease keep-alive streams are shared by many requests, so
+	// it is called with the context of the Watcher or Lease each time one
+	// is opened.
+	MetadataFromContext func(ctx context.Context) metadata.MD
+
+	// ReconnectLogger, if set, is notified of the client's reconnects.
+	ReconnectLogger ReconnectLogger
+
+	// MaxTxnOps is the maximum number of comparisons, and of operations in
+	// each branch, of a transaction; larger transactions fail with a
+	// TxnTooLargeError without being sent. It should match the server's
+	// limit. Zero uses DefaultMaxTxnOps; a negative value disables the check.
+	MaxTxnOps int
+
+	// Username is a username for authentication. If set with Password,
+	// the client authenticates on each connection and attaches the token
+	// to its requests; a token rejected by the server is renewed by
+	// reconnecting.
 	Username string
 
 	// Password is a password for authentication
@@ -41,10 +41,21 @@
 // The Client has internal state (watchers and leases), so Clients should be reused instead of created as needed.
 // Clients are safe for concurrent use by multiple goroutines.
 //
+// The gRPC version used by the client does not limit the size of messages on
+// either side of a connection, so there are no per-call message size options.
+// The only limit is the server's: a request whose encoding exceeds 1.5 MiB,
+// which bounds the key and value of a put, fails with rpctypes.ErrRequestTooLarge.
+// Responses are not limited, so a range over many large values should be paged
+// with WithLimit or Scan to bound the memory it takes.
+//
 // etcd client returns 2 types of errors:
 //
 //	1. context error: canceled or deadline exceeded.
-//	2. gRPC error: see https://github.com/coreos/etcd/blob/master/etcdserver/api/v3rpc/error.go.
+//	2. gRPC error: see https://github.com/coreos/etcd/blob/master/etcdserver/api/v3rpc/rpctypes/error.go.
+//
+// Known server errors are converted to the errors exported by rpctypes
+// (e.g., rpctypes.ErrCompacted, rpctypes.ErrNoSpace, rpctypes.ErrLeaseNotFound),
+// so they can be compared directly instead of matching on error strings.
 //
 // Here is the example code to handle client errors:
 //
@@ -54,8 +65,8 @@
 //			// ctx is canceled by another routine
 //		} else if err == context.DeadlineExceeded {
 //			// ctx is attached with a deadline and it exceeded
-//		} else if verr, ok := err.(*v3rpc.ErrEmptyKey); ok {
-//			// process (verr.Errors)
+//		} else if err == rpctypes.ErrEmptyKey {
+//			// the key is not provided
 //		} else {
 //			// bad cluster endpoints, which are not etcd servers
 //		}
@@ -0,0 +1,126 @@
+// Copyright 2016 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clientv3
+
+import (
+	"errors"
+	"time"
+
+	pb "github.com/coreos/etcd/etcdserver/etcdserverpb"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+var (
+	errConnUnhealthy = errors.New("etcdclient: connection failed health check")
+
+	// healthCheckKey is the key ranged over to probe a connection.
+	healthCheckKey = []byte("health")
+)
+
+// healthMonitor periodically checks the active connection and starts a
+// reconnect when it is unhealthy, so a connection that died silently is
+// replaced before requests fail on it.
+func (c *Client) healthMonitor() {
+	t := time.NewTicker(c.cfg.HealthCheckInterval)
+	defer t.Stop()
+	for {
+		select {
+		case <-t.C:
+		case <-c.ctx.Done():
+			return
+		}
+		conn := c.ActiveConnection()
+		if conn == nil {
+			// reconnect in progress
+			continue
+		}
+		if err := c.checkHealth(conn); err != nil && c.ActiveConnection() == conn {
+			c.connStartRetry(err)
+		}
+	}
+}
+
+// checkHealth returns an error if conn is failing or does not answer a
+// serializable range within the health check interval.
+func (c *Client) checkHealth(conn *grpc.ClientConn) error {
+	st, err := conn.State()
+	if err != nil || st == grpc.TransientFailure || st == grpc.Shutdown {
+		return errConnUnhealthy
+	}
+	ctx, cancel := context.WithTimeout(c.ctx, c.cfg.HealthCheckInterval)
+	defer cancel()
+	req := &pb.RangeRequest{Key: healthCheckKey, Serializable: true, CountOnly: true}
+	if _, err = pb.NewKVClient(conn).Range(ctx, req); err == nil || c.ctx.Err() != nil {
+		return nil
+	}
+	if !isRetryableErr(err) {
+		// the member answered; the request itself was refused
+		return nil
+	}
+	return err
+}
+
+// WaitReady blocks until the cluster answers a linearizable read through
+// the client, or ctx is done. A failed read moves the client to the next
+// endpoint, so it waits for any member to come up rather than only the
+// first one. Retries back off according to the client's RetryPolicy, if
+// any; its MaxAttempts is ignored since ctx bounds the wait.
+func (c *Client) WaitReady(ctx context.Context) error {
+	for attempt := 1; ; attempt++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		conn := c.ActiveConnection()
+		if conn == nil {
+			// no connection after a failed reconnect; dial again
+			if _, err := c.connWait(ctx, nil, nil); err == ErrClientClosed || ctx.Err() != nil {
+				return toErr(ctx, err)
+			}
+			continue
+		}
+		err := c.probeReady(ctx, conn)
+		if err == nil {
+			return nil
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if p := c.cfg.RetryPolicy; p != nil {
+			select {
+			case <-time.After(p.backoff(attempt)):
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		if _, err = c.connWait(ctx, conn, err); err == ErrClientClosed {
+			return err
+		}
+	}
+}
+
+// probeReady issues a linearizable read over conn. It returns nil if the
+// member answered, even if it refused the request (e.g., for lack of
+// permission on the probed key), since the member is serving requests.
+func (c *Client) probeReady(ctx context.Context, conn *grpc.ClientConn) error {
+	rctx, cancel := c.withRequestTimeout(ctx)
+	defer cancel()
+	req := &pb.RangeRequest{Key: healthCheckKey, CountOnly: true}
+	_, err := pb.NewKVClient(conn).Range(rctx, req)
+	if err != nil && !isRetryableErr(err) {
+		return nil
+	}
+	return err
+}
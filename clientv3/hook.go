@@ -0,0 +1,113 @@
+// Copyright 2016 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clientv3
+
+import (
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+// RequestHook is notified of each attempt of a client request, e.g., to
+// trace it. A request that is retried on a new connection makes several
+// attempts.
+type RequestHook interface {
+	// Start is called before an attempt is sent. The returned context is
+	// used for the attempt and passed to End, so it may carry a span.
+	Start(ctx context.Context, info RequestInfo) context.Context
+	// End is called once the attempt returns with its error, if any.
+	End(ctx context.Context, info RequestInfo, err error)
+}
+
+// RequestInfo describes an attempt of a client request.
+type RequestInfo struct {
+	// Method is the name of the RPC, e.g., "Range" or "LeaseGrant".
+	Method string
+	// Key is the key of the request, if it has one and keys are not
+	// redacted by Config.RedactHookKeys.
+	Key []byte
+	// Attempt is the number of the attempt, starting from 1.
+	Attempt int
+}
+
+// startAttempt notifies the client's RequestHook that an attempt of method
+// on key begins. It returns the context for the attempt, carrying the
+// client's request metadata, and a function to report its result.
+func (c *Client) startAttempt(ctx context.Context, method string, key []byte, attempt int) (context.Context, func(error)) {
+	ctx = c.withMetadata(ctx)
+	h := c.cfg.RequestHook
+	if h == nil {
+		return ctx, c.endAttempt
+	}
+	if c.cfg.RedactHookKeys {
+		key = nil
+	}
+	info := RequestInfo{Method: method, Key: key, Attempt: attempt}
+	hctx := h.Start(ctx, info)
+	return hctx, func(err error) {
+		h.End(hctx, info, err)
+		c.endAttempt(err)
+	}
+}
+
+// endAttempt refills the client's retry budget after a successful attempt.
+func (c *Client) endAttempt(err error) {
+	if err == nil {
+		c.retries.deposit()
+	}
+}
+
+// ReconnectLogger is notified of the reconnects a client runs in the
+// background after a connection fails, so failover can be traced.
+type ReconnectLogger interface {
+	// Reconnecting is called before the client dials a new connection.
+	Reconnecting(info ReconnectInfo)
+	// Reconnected is called once a new connection is established.
+	Reconnected(info ReconnectInfo)
+	// ReconnectFailed is called when the client fails to dial any endpoint.
+	ReconnectFailed(info ReconnectInfo, err error)
+}
+
+// ReconnectInfo describes a reconnect attempt of a client.
+type ReconnectInfo struct {
+	// Err is the error that triggered the reconnect, if any.
+	Err error
+	// From is the endpoint of the connection being replaced.
+	From string
+	// To is the endpoint of the new connection; it is only set once
+	// reconnected.
+	To string
+	// Attempt is the number of the attempt since the last established
+	// connection, starting from 1.
+	Attempt int
+}
+
+// logRetryConnection reconnects as retryConnection does, notifying the
+// client's ReconnectLogger, if any, of the attempt and its outcome.
+func (c *Client) logRetryConnection(err error, attempt int) (*grpc.ClientConn, error) {
+	l := c.cfg.ReconnectLogger
+	if l == nil {
+		return c.retryConnection(err)
+	}
+	info := ReconnectInfo{Err: err, From: c.curEndpoint(), Attempt: attempt}
+	l.Reconnecting(info)
+	conn, dialErr := c.retryConnection(err)
+	if dialErr != nil {
+		l.ReconnectFailed(info, dialErr)
+		return conn, dialErr
+	}
+	info.To = c.curEndpoint()
+	l.Reconnected(info)
+	return conn, nil
+}
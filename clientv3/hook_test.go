@@ -0,0 +1,181 @@
+// Copyright 2016 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clientv3
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+type hookKey struct{}
+
+type recordHook struct {
+	starts []RequestInfo
+	ends   []error
+	ctxs   []context.Context
+}
+
+func (h *recordHook) Start(ctx context.Context, info RequestInfo) context.Context {
+	h.starts = append(h.starts, info)
+	return context.WithValue(ctx, hookKey{}, len(h.starts))
+}
+
+func (h *recordHook) End(ctx context.Context, info RequestInfo, err error) {
+	h.ends = append(h.ends, err)
+	h.ctxs = append(h.ctxs, ctx)
+}
+
+func TestStartAttempt(t *testing.T) {
+	c := &Client{}
+	ctx := context.TODO()
+	if actx, end := c.startAttempt(ctx, "Range", []byte("foo"), 1); actx != ctx {
+		t.Errorf("expected unchanged context without a hook")
+	} else {
+		end(nil)
+	}
+
+	h := &recordHook{}
+	c.cfg.RequestHook = h
+	actx, end := c.startAttempt(ctx, "Range", []byte("foo"), 2)
+	if actx.Value(hookKey{}) != 1 {
+		t.Errorf("expected the attempt to use the hook's context")
+	}
+	rerr := errors.New("fail")
+	end(rerr)
+
+	c.cfg.RedactHookKeys = true
+	_, end = c.startAttempt(ctx, "Put", []byte("foo"), 1)
+	end(nil)
+
+	wstarts := []RequestInfo{
+		{Method: "Range", Key: []byte("foo"), Attempt: 2},
+		{Method: "Put", Attempt: 1},
+	}
+	if !reflect.DeepEqual(h.starts, wstarts) {
+		t.Errorf("starts = %+v, want %+v", h.starts, wstarts)
+	}
+	if !reflect.DeepEqual(h.ends, []error{rerr, nil}) {
+		t.Errorf("ends = %v, want %v", h.ends, []error{rerr, nil})
+	}
+	if h.ctxs[0] != actx {
+		t.Errorf("expected End to get the context returned by Start")
+	}
+}
+
+type recordReconnectLogger struct {
+	events []string
+	infos  []ReconnectInfo
+	errs   []error
+}
+
+func (l *recordReconnectLogger) Reconnecting(info ReconnectInfo) {
+	l.events = append(l.events, "reconnecting")
+	l.infos = append(l.infos, info)
+}
+
+func (l *recordReconnectLogger) Reconnected(info ReconnectInfo) {
+	l.events = append(l.events, "reconnected")
+	l.infos = append(l.infos, info)
+}
+
+func (l *recordReconnectLogger) ReconnectFailed(info ReconnectInfo, err error) {
+	l.events = append(l.events, "failed")
+	l.infos = append(l.infos, info)
+	l.errs = append(l.errs, err)
+}
+
+func TestLogRetryConnection(t *testing.T) {
+	derr := errors.New("dial failed")
+	dialErrs := []error{derr, nil}
+	cfg := Config{
+		Endpoints: []string{"a", "b", "c"},
+		RetryDialer: func(c *Client) (*grpc.ClientConn, error) {
+			err := dialErrs[0]
+			dialErrs = dialErrs[1:]
+			return nil, err
+		},
+	}
+	c := &Client{cfg: cfg, cancel: func() {}}
+
+	// no-op without a logger
+	c.cfg.RetryDialer = func(*Client) (*grpc.ClientConn, error) { return nil, nil }
+	if _, err := c.logRetryConnection(nil, 1); err != nil {
+		t.Fatal(err)
+	}
+
+	l := &recordReconnectLogger{}
+	c.cfg = cfg
+	c.cfg.ReconnectLogger = l
+	rerr := errors.New("conn broke")
+	if _, err := c.logRetryConnection(rerr, 1); err != derr {
+		t.Fatalf("err = %v, want %v", err, derr)
+	}
+	if _, err := c.logRetryConnection(rerr, 2); err != nil {
+		t.Fatal(err)
+	}
+
+	wevents := []string{"reconnecting", "failed", "reconnecting", "reconnected"}
+	if !reflect.DeepEqual(l.events, wevents) {
+		t.Errorf("events = %v, want %v", l.events, wevents)
+	}
+	winfos := []ReconnectInfo{
+		{Err: rerr, From: "a", Attempt: 1},
+		{Err: rerr, From: "a", Attempt: 1},
+		// each failure moves on to the next endpoint
+		{Err: rerr, From: "b", Attempt: 2},
+		{Err: rerr, From: "b", To: "c", Attempt: 2},
+	}
+	if !reflect.DeepEqual(l.infos, winfos) {
+		t.Errorf("infos = %+v, want %+v", l.infos, winfos)
+	}
+	if !reflect.DeepEqual(l.errs, []error{derr}) {
+		t.Errorf("errs = %v, want %v", l.errs, []error{derr})
+	}
+}
+
+type requestIDKey struct{}
+
+func TestStartAttemptMetadata(t *testing.T) {
+	c := &Client{}
+	c.cfg.MetadataFromContext = func(ctx context.Context) metadata.MD {
+		id, _ := ctx.Value(requestIDKey{}).(string)
+		if id == "" {
+			return nil
+		}
+		return metadata.Pairs("request-id", id)
+	}
+
+	ctx := context.TODO()
+	if actx, _ := c.startAttempt(ctx, "Range", nil, 1); actx != ctx {
+		t.Errorf("expected unchanged context without metadata")
+	}
+
+	ctx = WithRequireLeader(context.WithValue(ctx, requestIDKey{}, "abc"))
+	for attempt := 1; attempt <= 2; attempt++ {
+		actx, _ := c.startAttempt(ctx, "Range", nil, attempt)
+		md, _ := metadata.FromContext(actx)
+		if ids := md["request-id"]; !reflect.DeepEqual(ids, []string{"abc"}) {
+			t.Errorf("#%d: request-id = %v, want %v", attempt, ids, []string{"abc"})
+		}
+		if !requiresLeader(actx) {
+			t.Errorf("#%d: expected the require leader metadata to be kept", attempt)
+		}
+	}
+}
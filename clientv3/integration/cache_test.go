@@ -0,0 +1,152 @@
+// Copyright 2016 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package integration
+
+import (
+	"testing"
+	"time"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/coreos/etcd/clientv3/cache"
+	"github.com/coreos/etcd/integration"
+	"github.com/coreos/etcd/pkg/testutil"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"golang.org/x/net/context"
+)
+
+// cacheGets returns the number of Gets answered from and missing the cache.
+func cacheGets(t *testing.T, kv *cache.KV) (hits, misses float64) {
+	ch := make(chan prometheus.Metric, 16)
+	kv.Collect(ch)
+	close(ch)
+	for m := range ch {
+		var pm dto.Metric
+		if err := m.Write(&pm); err != nil {
+			t.Fatal(err)
+		}
+		switch pm.Label[0].GetValue() {
+		case "hit":
+			hits = pm.GetCounter().GetValue()
+		case "miss":
+			misses = pm.GetCounter().GetValue()
+		}
+	}
+	return hits, misses
+}
+
+func TestCacheGet(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	cli := clus.Client(0)
+	if _, err := cli.Put(context.TODO(), "foo/a", "1"); err != nil {
+		t.Fatal(err)
+	}
+	kv, err := cache.NewKV(context.TODO(), cli.KV, cli.Watcher, 2, "foo/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer kv.Close()
+
+	for i := 0; i < 3; i++ {
+		resp, err := kv.Get(context.TODO(), "foo/a")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(resp.Kvs) != 1 || string(resp.Kvs[0].Value) != "1" {
+			t.Fatalf("#%d: expected value %q, got %+v", i, "1", resp.Kvs)
+		}
+	}
+	if hits, misses := cacheGets(t, kv); hits != 2 || misses != 1 {
+		t.Errorf("hits, misses = %v, %v, want 2, 1", hits, misses)
+	}
+
+	// keys outside the prefix and Gets with options are not cached
+	if _, err := kv.Get(context.TODO(), "bar"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := kv.Get(context.TODO(), "foo/", clientv3.WithPrefix()); err != nil {
+		t.Fatal(err)
+	}
+	if hits, misses := cacheGets(t, kv); hits != 2 || misses != 1 {
+		t.Errorf("hits, misses = %v, %v, want 2, 1", hits, misses)
+	}
+}
+
+func TestCacheInvalidate(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	cli := clus.Client(0)
+	kv, err := cache.NewKV(context.TODO(), cli.KV, cli.Watcher, 2, "foo/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer kv.Close()
+
+	getValue := func(key string) string {
+		resp, err := kv.Get(context.TODO(), key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(resp.Kvs) == 0 {
+			return ""
+		}
+		return string(resp.Kvs[0].Value)
+	}
+
+	// a write through the cache is seen right away
+	if getValue("foo/a") != "" {
+		t.Fatalf("expected missing key")
+	}
+	if _, err := kv.Put(context.TODO(), "foo/a", "1"); err != nil {
+		t.Fatal(err)
+	}
+	if v := getValue("foo/a"); v != "1" {
+		t.Fatalf("value = %q, want %q", v, "1")
+	}
+	if _, err := kv.Txn(context.TODO()).Then(clientv3.OpPut("foo/a", "2")).Commit(); err != nil {
+		t.Fatal(err)
+	}
+	if v := getValue("foo/a"); v != "2" {
+		t.Fatalf("value = %q, want %q", v, "2")
+	}
+
+	// a write by another client is seen once the watch reports it
+	if _, err := cli.Put(context.TODO(), "foo/a", "3"); err != nil {
+		t.Fatal(err)
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for getValue("foo/a") != "3" {
+		if time.Now().After(deadline) {
+			t.Fatalf("cache not invalidated by watch")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// the least recently used key is evicted
+	_, misses := cacheGets(t, kv)
+	getValue("foo/b")
+	getValue("foo/c")
+	getValue("foo/a")
+	if _, m := cacheGets(t, kv); m != misses+3 {
+		t.Errorf("misses = %v, want %v", m, misses+3)
+	}
+}
@@ -16,7 +16,9 @@ package integration
 
 import (
 	"reflect"
+	"sort"
 	"testing"
+	"time"
 
 	"github.com/coreos/etcd/clientv3"
 	"github.com/coreos/etcd/integration"
@@ -43,6 +45,48 @@ func TestMemberList(t *testing.T) {
 	}
 }
 
+// TestClientAutoSync ensures the client endpoints are refreshed from the
+// member list.
+func TestClientAutoSync(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 3})
+	defer clus.Terminate(t)
+
+	cli, err := clientv3.New(clientv3.Config{
+		Endpoints:        []string{clus.Members[0].GRPCAddr()},
+		AutoSyncInterval: 50 * time.Millisecond,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cli.Close()
+
+	var weps []string
+	for _, m := range clus.Members {
+		weps = append(weps, m.URL())
+	}
+	sort.Strings(weps)
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		eps := append([]string{}, cli.Endpoints()...)
+		sort.Strings(eps)
+		if reflect.DeepEqual(eps, weps) {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("endpoints = %v, want %v", eps, weps)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// requests still go to the active connection
+	if _, err := cli.Get(context.TODO(), "foo"); err != nil {
+		t.Fatal(err)
+	}
+}
+
 func TestMemberAdd(t *testing.T) {
 	defer testutil.AfterTest(t)
 
@@ -60,6 +104,9 @@ func TestMemberAdd(t *testing.T) {
 	if !reflect.DeepEqual(resp.Member.PeerURLs, urls) {
 		t.Errorf("urls = %v, want %v", urls, resp.Member.PeerURLs)
 	}
+	if len(resp.Members) != 4 {
+		t.Errorf("number of members = %d, want %d", len(resp.Members), 4)
+	}
 }
 
 func TestMemberRemove(t *testing.T) {
@@ -85,10 +132,13 @@ func TestMemberRemove(t *testing.T) {
 		}
 	}
 
-	_, err = capi.MemberRemove(context.Background(), rmvID)
+	rresp, err := capi.MemberRemove(context.Background(), rmvID)
 	if err != nil {
 		t.Fatalf("failed to remove member %v", err)
 	}
+	if len(rresp.Members) != 2 {
+		t.Errorf("number of members = %d, want %d", len(rresp.Members), 2)
+	}
 
 	resp, err = capi.MemberList(context.Background())
 	if err != nil {
@@ -113,10 +163,15 @@ func TestMemberUpdate(t *testing.T) {
 	}
 
 	urls := []string{"http://127.0.0.1:1234"}
-	_, err = capi.MemberUpdate(context.Background(), resp.Members[0].ID, urls)
+	uresp, err := capi.MemberUpdate(context.Background(), resp.Members[0].ID, urls)
 	if err != nil {
 		t.Fatalf("failed to update member %v", err)
 	}
+	for _, m := range uresp.Members {
+		if m.ID == resp.Members[0].ID && !reflect.DeepEqual(m.PeerURLs, urls) {
+			t.Errorf("urls = %v, want %v", m.PeerURLs, urls)
+		}
+	}
 
 	resp, err = capi.MemberList(context.Background())
 	if err != nil {
@@ -16,8 +16,10 @@ package integration
 
 import (
 	"bytes"
+	"fmt"
 	"reflect"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -27,6 +29,7 @@ import (
 	"github.com/coreos/etcd/mvcc/mvccpb"
 	"github.com/coreos/etcd/pkg/testutil"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc"
 )
 
 func TestKVPutError(t *testing.T) {
@@ -110,6 +113,129 @@ func TestKVPut(t *testing.T) {
 	}
 }
 
+func TestKVPutWithIgnoreValue(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	kv := clientv3.NewKV(clus.RandClient())
+	ctx := context.TODO()
+
+	if _, err := kv.Put(ctx, "foo", "", clientv3.WithIgnoreValue()); err != rpctypes.ErrKeyNotFound {
+		t.Fatalf("err = %v, want %v", err, rpctypes.ErrKeyNotFound)
+	}
+	_, err := kv.Txn(ctx).Then(clientv3.OpPut("foo", "", clientv3.WithIgnoreValue())).Commit()
+	if err != rpctypes.ErrKeyNotFound {
+		t.Fatalf("txn err = %v, want %v", err, rpctypes.ErrKeyNotFound)
+	}
+
+	if _, err := kv.Put(ctx, "foo", "bar"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := kv.Put(ctx, "foo", "baz", clientv3.WithIgnoreValue()); err != rpctypes.ErrValueProvided {
+		t.Fatalf("err = %v, want %v", err, rpctypes.ErrValueProvided)
+	}
+
+	lresp, err := clus.RandClient().Grant(ctx, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := kv.Put(ctx, "foo", "", clientv3.WithIgnoreValue(), clientv3.WithLease(lresp.ID)); err != nil {
+		t.Fatal(err)
+	}
+	resp, err := kv.Get(ctx, "foo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Kvs) != 1 {
+		t.Fatalf("expected 1 key, got %d", len(resp.Kvs))
+	}
+	if string(resp.Kvs[0].Value) != "bar" {
+		t.Errorf("val = %q, want %q", resp.Kvs[0].Value, "bar")
+	}
+	if clientv3.LeaseID(resp.Kvs[0].Lease) != lresp.ID {
+		t.Errorf("lease = %x, want %x", resp.Kvs[0].Lease, lresp.ID)
+	}
+}
+
+func TestKVPutWithIgnoreLease(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	kv := clientv3.NewKV(clus.RandClient())
+	ctx := context.TODO()
+
+	if _, err := kv.Put(ctx, "foo", "bar", clientv3.WithIgnoreLease()); err != rpctypes.ErrKeyNotFound {
+		t.Fatalf("err = %v, want %v", err, rpctypes.ErrKeyNotFound)
+	}
+
+	lresp, err := clus.RandClient().Grant(ctx, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := kv.Put(ctx, "foo", "bar", clientv3.WithLease(lresp.ID)); err != nil {
+		t.Fatal(err)
+	}
+	// the lease is ignored, even if it does not exist
+	if _, err := kv.Put(ctx, "foo", "baz", clientv3.WithIgnoreLease(), clientv3.WithLease(lresp.ID+1)); err != nil {
+		t.Fatal(err)
+	}
+	_, err = kv.Txn(ctx).Then(clientv3.OpPut("foo", "qux", clientv3.WithIgnoreLease())).Commit()
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := kv.Get(ctx, "foo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Kvs) != 1 {
+		t.Fatalf("expected 1 key, got %d", len(resp.Kvs))
+	}
+	if string(resp.Kvs[0].Value) != "qux" {
+		t.Errorf("val = %q, want %q", resp.Kvs[0].Value, "qux")
+	}
+	if clientv3.LeaseID(resp.Kvs[0].Lease) != lresp.ID {
+		t.Errorf("lease = %x, want %x", resp.Kvs[0].Lease, lresp.ID)
+	}
+}
+
+func TestKVPutWithPrevKV(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	kv := clientv3.NewKV(clus.RandClient())
+	ctx := context.TODO()
+
+	resp, err := kv.Put(ctx, "foo", "bar", clientv3.WithPrevKV())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.PrevKv != nil {
+		t.Errorf("prev kv = %+v, want nil for a new key", resp.PrevKv)
+	}
+
+	resp, err = kv.Put(ctx, "foo", "baz", clientv3.WithPrevKV())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.PrevKv == nil || string(resp.PrevKv.Key) != "foo" || string(resp.PrevKv.Value) != "bar" {
+		t.Errorf("prev kv = %+v, want foo=bar", resp.PrevKv)
+	}
+
+	// not requested
+	if resp, err = kv.Put(ctx, "foo", "qux"); err != nil {
+		t.Fatal(err)
+	}
+	if resp.PrevKv != nil {
+		t.Errorf("prev kv = %+v, want nil", resp.PrevKv)
+	}
+}
+
 func TestKVPutWithRequireLeader(t *testing.T) {
 	defer testutil.AfterTest(t)
 
@@ -133,6 +259,31 @@ func TestKVPutWithRequireLeader(t *testing.T) {
 	}
 }
 
+// TestKVGetWithLeaderRequired ensures a read requiring a leader fails
+// promptly on a member without one instead of waiting for an election.
+func TestKVGetWithLeaderRequired(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 3})
+	defer clus.Terminate(t)
+
+	clus.Members[1].Stop(t)
+	clus.Members[2].Stop(t)
+
+	// wait for election timeout, then member[0] will not have a leader.
+	time.Sleep(time.Duration(3*10) * 10 * time.Millisecond)
+
+	kv := clientv3.NewKV(clus.Client(0))
+	start := time.Now()
+	_, err := kv.Get(context.Background(), "foo", clientv3.WithLeaderRequired())
+	if err != rpctypes.ErrNoLeader {
+		t.Fatalf("err = %v, want %v", err, rpctypes.ErrNoLeader)
+	}
+	if d := time.Since(start); d > time.Second {
+		t.Errorf("took %v to fail, want under 1s", d)
+	}
+}
+
 func TestKVRange(t *testing.T) {
 	defer testutil.AfterTest(t)
 
@@ -249,6 +400,16 @@ func TestKVRange(t *testing.T) {
 				{Key: []byte("foo/abc"), Value: nil, CreateRevision: 8, ModRevision: 8, Version: 1},
 			},
 		},
+		// WithPrefix with sort and limit
+		{
+			"foo", "",
+			0,
+			[]clientv3.OpOption{clientv3.WithPrefix(), clientv3.WithSort(clientv3.SortByKey, clientv3.SortDescend), clientv3.WithLimit(1)},
+
+			[]*mvccpb.KeyValue{
+				{Key: []byte("foo/abc"), Value: nil, CreateRevision: 8, ModRevision: 8, Version: 1},
+			},
+		},
 		// WithFromKey
 		{
 			"fo", "",
@@ -279,111 +440,82 @@ func TestKVRange(t *testing.T) {
 	}
 }
 
-func TestKVDeleteRange(t *testing.T) {
+func TestKVGetPrefix(t *testing.T) {
 	defer testutil.AfterTest(t)
 
-	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 3})
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 1})
 	defer clus.Terminate(t)
 
 	kv := clientv3.NewKV(clus.RandClient())
 	ctx := context.TODO()
 
+	for _, k := range []string{"fo", "foo/a", "foo/b", "foo/c", "fop"} {
+		if _, err := kv.Put(ctx, k, ""); err != nil {
+			t.Fatal(err)
+		}
+	}
+
 	tests := []struct {
-		key  string
 		opts []clientv3.OpOption
 
 		wkeys []string
 	}{
-		// [a, c)
-		{
-			key:  "a",
-			opts: []clientv3.OpOption{clientv3.WithRange("c")},
-
-			wkeys: []string{"c", "c/abc", "d"},
-		},
-		// >= c
-		{
-			key:  "c",
-			opts: []clientv3.OpOption{clientv3.WithFromKey()},
-
-			wkeys: []string{"a", "b"},
-		},
-		// c*
-		{
-			key:  "c",
-			opts: []clientv3.OpOption{clientv3.WithPrefix()},
-
-			wkeys: []string{"a", "b", "d"},
-		},
-		// *
-		{
-			key:  "\x00",
-			opts: []clientv3.OpOption{clientv3.WithFromKey()},
-
-			wkeys: []string{},
-		},
+		{nil, []string{"foo/a", "foo/b", "foo/c"}},
+		{[]clientv3.OpOption{clientv3.WithSortByKey(clientv3.SortDescend), clientv3.WithLimit(2)}, []string{"foo/c", "foo/b"}},
 	}
-
 	for i, tt := range tests {
-		keySet := []string{"a", "b", "c", "c/abc", "d"}
-		for j, key := range keySet {
-			if _, err := kv.Put(ctx, key, ""); err != nil {
-				t.Fatalf("#%d: couldn't put %q (%v)", j, key, err)
-			}
-		}
-
-		_, err := kv.Delete(ctx, tt.key, tt.opts...)
-		if err != nil {
-			t.Fatalf("#%d: couldn't delete range (%v)", i, err)
-		}
-
-		resp, err := kv.Get(ctx, "a", clientv3.WithFromKey())
+		resp, err := clientv3.GetPrefix(ctx, kv, "foo/", tt.opts...)
 		if err != nil {
-			t.Fatalf("#%d: couldn't get keys (%v)", i, err)
+			t.Fatalf("#%d: couldn't get prefix (%v)", i, err)
 		}
-		keys := []string{}
-		for _, kv := range resp.Kvs {
-			keys = append(keys, string(kv.Key))
+		var keys []string
+		for _, ev := range resp.Kvs {
+			keys = append(keys, string(ev.Key))
 		}
-		if !reflect.DeepEqual(tt.wkeys, keys) {
-			t.Errorf("#%d: resp.Kvs got %v, expected %v", i, keys, tt.wkeys)
+		if !reflect.DeepEqual(keys, tt.wkeys) {
+			t.Errorf("#%d: keys = %v, want %v", i, keys, tt.wkeys)
 		}
 	}
 }
 
-func TestKVDelete(t *testing.T) {
+func TestKVGetOldest(t *testing.T) {
 	defer testutil.AfterTest(t)
 
-	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 3})
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 1})
 	defer clus.Terminate(t)
 
 	kv := clientv3.NewKV(clus.RandClient())
 	ctx := context.TODO()
 
-	presp, err := kv.Put(ctx, "foo", "")
-	if err != nil {
-		t.Fatalf("couldn't put 'foo' (%v)", err)
-	}
-	if presp.Header.Revision != 2 {
-		t.Fatalf("presp.Header.Revision got %d, want %d", presp.Header.Revision, 2)
-	}
-	resp, err := kv.Delete(ctx, "foo")
-	if err != nil {
-		t.Fatalf("couldn't delete key (%v)", err)
-	}
-	if resp.Header.Revision != 3 {
-		t.Fatalf("resp.Header.Revision got %d, want %d", resp.Header.Revision, 3)
+	// created out of key order; updating foo/c keeps its create revision
+	for _, k := range []string{"foo/c", "fo", "foo/a", "foo/b", "foo/c", "fop"} {
+		if _, err := kv.Put(ctx, k, ""); err != nil {
+			t.Fatal(err)
+		}
 	}
-	gresp, err := kv.Get(ctx, "foo")
-	if err != nil {
-		t.Fatalf("couldn't get key (%v)", err)
+
+	tests := []struct {
+		n int64
+
+		wkeys []string
+	}{
+		{0, []string{"foo/c", "foo/a", "foo/b"}},
+		{1, []string{"foo/c"}},
+		{2, []string{"foo/c", "foo/a"}},
+		{5, []string{"foo/c", "foo/a", "foo/b"}},
 	}
-	if len(gresp.Kvs) > 0 {
-		t.Fatalf("gresp.Kvs got %+v, want none", gresp.Kvs)
+	for i, tt := range tests {
+		resp, err := clientv3.GetOldest(ctx, kv, "foo/", tt.n)
+		if err != nil {
+			t.Fatalf("#%d: couldn't get oldest (%v)", i, err)
+		}
+		if keys := resp.Keys(); !reflect.DeepEqual(keys, tt.wkeys) {
+			t.Errorf("#%d: keys = %v, want %v", i, keys, tt.wkeys)
+		}
 	}
 }
 
-func TestKVCompactError(t *testing.T) {
+func TestKVGetWithRev(t *testing.T) {
 	defer testutil.AfterTest(t)
 
 	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 1})
@@ -392,84 +524,826 @@ func TestKVCompactError(t *testing.T) {
 	kv := clientv3.NewKV(clus.RandClient())
 	ctx := context.TODO()
 
-	for i := 0; i < 5; i++ {
-		if _, err := kv.Put(ctx, "foo", "bar"); err != nil {
-			t.Fatalf("couldn't put 'foo' (%v)", err)
+	// revisions 2, 3, 4, 5
+	for _, p := range [][2]string{{"cfg/a", "1"}, {"cfg/b", "1"}, {"cfg/a", "2"}, {"cfg/c", "1"}} {
+		if _, err := kv.Put(ctx, p[0], p[1]); err != nil {
+			t.Fatal(err)
 		}
 	}
-	err := kv.Compact(ctx, 6)
+
+	resp, err := kv.Get(ctx, "cfg/a", clientv3.WithRev(3))
 	if err != nil {
-		t.Fatalf("couldn't compact 6 (%v)", err)
+		t.Fatal(err)
+	}
+	if len(resp.Kvs) != 1 || string(resp.Kvs[0].Value) != "1" {
+		t.Errorf("kvs = %+v, want cfg/a=1", resp.Kvs)
 	}
 
-	err = kv.Compact(ctx, 6)
-	if err != rpctypes.ErrCompacted {
-		t.Fatalf("expected %v, got %v", rpctypes.ErrCompacted, err)
+	resp, err = kv.Get(ctx, "cfg/", clientv3.WithPrefix(), clientv3.WithRev(4))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, ev := range resp.Kvs {
+		got = append(got, string(ev.Key)+"="+string(ev.Value))
+	}
+	if want := []string{"cfg/a=2", "cfg/b=1"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("kvs = %v, want %v", got, want)
 	}
 
-	err = kv.Compact(ctx, 100)
-	if err != rpctypes.ErrFutureRev {
-		t.Fatalf("expected %v, got %v", rpctypes.ErrFutureRev, err)
+	if err = kv.Compact(ctx, 4); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = kv.Get(ctx, "cfg/a", clientv3.WithRev(3)); err != rpctypes.ErrCompacted {
+		t.Errorf("err = %v, want %v", err, rpctypes.ErrCompacted)
+	}
+	if _, err = kv.Get(ctx, "cfg/", clientv3.WithPrefix(), clientv3.WithRev(3)); err != rpctypes.ErrCompacted {
+		t.Errorf("prefix err = %v, want %v", err, rpctypes.ErrCompacted)
+	}
+	if _, err = kv.Get(ctx, "cfg/a", clientv3.WithRev(100)); err != rpctypes.ErrFutureRev {
+		t.Errorf("future err = %v, want %v", err, rpctypes.ErrFutureRev)
 	}
 }
 
-func TestKVCompact(t *testing.T) {
+func TestKVGetCreateRevFilter(t *testing.T) {
 	defer testutil.AfterTest(t)
 
-	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 3})
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 1})
 	defer clus.Terminate(t)
 
 	kv := clientv3.NewKV(clus.RandClient())
 	ctx := context.TODO()
 
-	for i := 0; i < 10; i++ {
-		if _, err := kv.Put(ctx, "foo", "bar"); err != nil {
-			t.Fatalf("couldn't put 'foo' (%v)", err)
+	// created at revisions 2, 3, 4, 5, 6; "ev/a" is updated at revision 7
+	for _, k := range []string{"ev/a", "ev/b", "other", "ev/c", "ev/d", "ev/a"} {
+		if _, err := kv.Put(ctx, k, ""); err != nil {
+			t.Fatal(err)
 		}
 	}
 
-	err := kv.Compact(ctx, 7)
-	if err != nil {
-		t.Fatalf("couldn't compact kv space (%v)", err)
-	}
-	err = kv.Compact(ctx, 7)
-	if err == nil || err != rpctypes.ErrCompacted {
-		t.Fatalf("error got %v, want %v", err, rpctypes.ErrCompacted)
-	}
-
-	wcli := clus.RandClient()
-	// new watcher could precede receiving the compaction without quorum first
-	wcli.Get(ctx, "quorum-get")
-
-	wc := clientv3.NewWatcher(wcli)
-	defer wc.Close()
-	wchan := wc.Watch(ctx, "foo", clientv3.WithRev(3))
+	tests := []struct {
+		opts []clientv3.OpOption
 
-	if wr := <-wchan; wr.CompactRevision != 7 {
-		t.Fatalf("wchan CompactRevision got %v, want 7", wr.CompactRevision)
+		wkeys []string
+	}{
+		{[]clientv3.OpOption{clientv3.WithMinCreateRev(3)}, []string{"ev/b", "ev/c", "ev/d"}},
+		{[]clientv3.OpOption{clientv3.WithMaxCreateRev(5)}, []string{"ev/a", "ev/b", "ev/c"}},
+		{[]clientv3.OpOption{clientv3.WithMinCreateRev(3), clientv3.WithMaxCreateRev(5)}, []string{"ev/b", "ev/c"}},
+		{[]clientv3.OpOption{clientv3.WithMinCreateRev(7)}, nil},
+		{[]clientv3.OpOption{clientv3.WithMinCreateRev(3), clientv3.WithLimit(1)}, []string{"ev/b"}},
 	}
-	if wr, ok := <-wchan; ok {
-		t.Fatalf("wchan got %v, expected closed", wr)
+	for i, tt := range tests {
+		resp, err := kv.Get(ctx, "ev/", append(tt.opts, clientv3.WithPrefix())...)
+		if err != nil {
+			t.Fatalf("#%d: couldn't get (%v)", i, err)
+		}
+		var keys []string
+		for _, ev := range resp.Kvs {
+			keys = append(keys, string(ev.Key))
+		}
+		if !reflect.DeepEqual(keys, tt.wkeys) {
+			t.Errorf("#%d: keys = %v, want %v", i, keys, tt.wkeys)
+		}
 	}
 
-	err = kv.Compact(ctx, 1000)
-	if err == nil || err != rpctypes.ErrFutureRev {
-		t.Fatalf("error got %v, want %v", err, rpctypes.ErrFutureRev)
+	resp, err := kv.Get(ctx, "ev/", clientv3.WithPrefix(), clientv3.WithMinCreateRev(3), clientv3.WithCountOnly())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Count != 3 {
+		t.Errorf("count = %d, want 3", resp.Count)
 	}
 }
 
-// TestKVGetRetry ensures get will retry on disconnect.
-func TestKVGetRetry(t *testing.T) {
+// TestKVGetModRevFilter ensures the mod revision bounds are inclusive.
+func TestKVGetModRevFilter(t *testing.T) {
 	defer testutil.AfterTest(t)
 
-	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 3})
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 1})
 	defer clus.Terminate(t)
 
-	kv := clientv3.NewKV(clus.Client(0))
+	kv := clientv3.NewKV(clus.RandClient())
 	ctx := context.TODO()
 
-	if _, err := kv.Put(ctx, "foo", "bar"); err != nil {
-		t.Fatal(err)
+	// "res/a" at 2, "res/b" at 3, "res/c" at 4, then "res/a" modified at 5
+	for _, k := range []string{"res/a", "res/b", "res/c", "res/a"} {
+		if _, err := kv.Put(ctx, k, "v"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		opts []clientv3.OpOption
+
+		wkeys []string
+	}{
+		{[]clientv3.OpOption{clientv3.WithMinModRev(4)}, []string{"res/a", "res/c"}},
+		{[]clientv3.OpOption{clientv3.WithMaxModRev(3)}, []string{"res/b"}},
+		{[]clientv3.OpOption{clientv3.WithMinModRev(3), clientv3.WithMaxModRev(4)}, []string{"res/b", "res/c"}},
+		{[]clientv3.OpOption{clientv3.WithMinModRev(5), clientv3.WithMaxModRev(5)}, []string{"res/a"}},
+		{[]clientv3.OpOption{clientv3.WithMinModRev(6)}, nil},
+		{[]clientv3.OpOption{clientv3.WithMinModRev(3), clientv3.WithMaxCreateRev(2)}, []string{"res/a"}},
+	}
+	for i, tt := range tests {
+		opts := append(tt.opts, clientv3.WithPrefix(), clientv3.WithKeysOnly())
+		resp, err := kv.Get(ctx, "res/", opts...)
+		if err != nil {
+			t.Fatalf("#%d: couldn't get (%v)", i, err)
+		}
+		var keys []string
+		for _, ev := range resp.Kvs {
+			if len(ev.Value) != 0 {
+				t.Errorf("#%d: value = %q, want empty", i, ev.Value)
+			}
+			keys = append(keys, string(ev.Key))
+		}
+		if !reflect.DeepEqual(keys, tt.wkeys) {
+			t.Errorf("#%d: keys = %v, want %v", i, keys, tt.wkeys)
+		}
+	}
+}
+
+func TestKVGetCountOnly(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	kv := clientv3.NewKV(clus.RandClient())
+	ctx := context.TODO()
+
+	keySet := []string{"jobs/a", "jobs/b", "jobs/c", "jobz"}
+	for i, key := range keySet {
+		if _, err := kv.Put(ctx, key, "some value"); err != nil {
+			t.Fatalf("#%d: couldn't put %q (%v)", i, key, err)
+		}
+	}
+
+	tests := []struct {
+		key  string
+		opts []clientv3.OpOption
+
+		wcount int64
+	}{
+		{"jobs/", []clientv3.OpOption{clientv3.WithPrefix()}, 3},
+		{"jobs/a", nil, 1},
+		{"nope", nil, 0},
+		// count ignores limit
+		{"jobs/", []clientv3.OpOption{clientv3.WithPrefix(), clientv3.WithLimit(1)}, 3},
+		{"", []clientv3.OpOption{clientv3.WithFromKey()}, 4},
+	}
+	for i, tt := range tests {
+		opts := append(tt.opts, clientv3.WithCountOnly())
+		resp, err := kv.Get(ctx, tt.key, opts...)
+		if err != nil {
+			t.Fatalf("#%d: couldn't get %q (%v)", i, tt.key, err)
+		}
+		if resp.Count != tt.wcount {
+			t.Errorf("#%d: count = %d, want %d", i, resp.Count, tt.wcount)
+		}
+		if len(resp.Kvs) != 0 {
+			t.Errorf("#%d: len(kvs) = %d, want 0", i, len(resp.Kvs))
+		}
+		if resp.Header.Revision != int64(len(keySet)+1) {
+			t.Errorf("#%d: revision = %d, want %d", i, resp.Header.Revision, len(keySet)+1)
+		}
+	}
+}
+
+func TestKVGetKeysOnly(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	kv := clientv3.NewKV(clus.RandClient())
+	ctx := context.TODO()
+
+	lresp, err := clus.RandClient().Grant(ctx, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = kv.Put(ctx, "blobs/a", "large value", clientv3.WithLease(lresp.ID)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = kv.Put(ctx, "blobs/b", "another large value"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = kv.Put(ctx, "blobs/b", "updated large value"); err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := kv.Get(ctx, "blobs/", clientv3.WithPrefix(), clientv3.WithKeysOnly())
+	if err != nil {
+		t.Fatal(err)
+	}
+	wkvs := []*mvccpb.KeyValue{
+		{Key: []byte("blobs/a"), CreateRevision: 2, ModRevision: 2, Version: 1, Lease: int64(lresp.ID)},
+		{Key: []byte("blobs/b"), CreateRevision: 3, ModRevision: 4, Version: 2},
+	}
+	if !reflect.DeepEqual(resp.Kvs, wkvs) {
+		t.Fatalf("kvs = %+v, want %+v", resp.Kvs, wkvs)
+	}
+}
+
+// TestKVGetPaginate pages over the keyspace with WithLimit and ensures
+// More reports whether keys remain beyond the limit.
+func TestKVGetPaginate(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	kv := clientv3.NewKV(clus.RandClient())
+	ctx := context.TODO()
+
+	keys := []string{"a", "b", "c", "d", "e"}
+	for _, k := range keys {
+		if _, err := kv.Put(ctx, k, ""); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var (
+		got  []string
+		more []bool
+	)
+	for key := "\x00"; ; {
+		resp, err := kv.Get(ctx, key, clientv3.WithFromKey(), clientv3.WithSortByKey(clientv3.SortAscend), clientv3.WithLimit(2))
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, ev := range resp.Kvs {
+			got = append(got, string(ev.Key))
+		}
+		more = append(more, resp.More)
+		if !resp.More {
+			break
+		}
+		key = string(resp.Kvs[len(resp.Kvs)-1].Key) + "\x00"
+	}
+	if !reflect.DeepEqual(got, keys) {
+		t.Errorf("keys = %v, want %v", got, keys)
+	}
+	if wmore := []bool{true, true, false}; !reflect.DeepEqual(more, wmore) {
+		t.Errorf("more = %v, want %v", more, wmore)
+	}
+}
+
+func TestKVScan(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	kv := clientv3.NewKV(clus.RandClient())
+	ctx := context.TODO()
+
+	keys := []string{"s/a", "s/b", "s/c", "s/d", "s/e"}
+	for _, k := range append(keys, "t") {
+		if _, err := kv.Put(ctx, k, "v"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var got []string
+	err := clientv3.Scan(ctx, kv, "s/", 2, func(ev *mvccpb.KeyValue) error {
+		if len(got) == 0 {
+			// writes after the first page are not visible to the scan
+			if _, err := kv.Put(ctx, "s/f", "v"); err != nil {
+				return err
+			}
+			if _, err := kv.Delete(ctx, "s/e"); err != nil {
+				return err
+			}
+		}
+		got = append(got, string(ev.Key))
+		return nil
+	}, clientv3.WithKeysOnly())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, keys) {
+		t.Errorf("keys = %v, want %v", got, keys)
+	}
+
+	errStop := fmt.Errorf("stop")
+	n := 0
+	err = clientv3.Scan(ctx, kv, "s/", 0, func(ev *mvccpb.KeyValue) error {
+		n++
+		return errStop
+	})
+	if err != errStop || n != 1 {
+		t.Errorf("err = %v after %d keys, want %v after 1 key", err, n, errStop)
+	}
+}
+
+func TestKVDo(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	kv := clientv3.NewKV(clus.RandClient())
+	ctx := context.TODO()
+
+	ops := []clientv3.Op{
+		clientv3.OpPut("foo", "bar"),
+		clientv3.OpGet("foo"),
+		clientv3.OpDelete("foo"),
+	}
+	for i, op := range ops {
+		resp, err := kv.Do(ctx, op)
+		if err != nil {
+			t.Fatalf("#%d: couldn't do op (%v)", i, err)
+		}
+		switch i {
+		case 0:
+			if resp.Put() == nil || resp.Get() != nil || resp.Del() != nil {
+				t.Errorf("#%d: expected only put response, got %+v", i, resp)
+			}
+		case 1:
+			gresp := resp.Get()
+			if gresp == nil || resp.Put() != nil || resp.Del() != nil {
+				t.Fatalf("#%d: expected only get response, got %+v", i, resp)
+			}
+			if len(gresp.Kvs) != 1 || string(gresp.Kvs[0].Value) != "bar" {
+				t.Errorf("#%d: kvs = %+v, want foo=bar", i, gresp.Kvs)
+			}
+		case 2:
+			dresp := resp.Del()
+			if dresp == nil || resp.Put() != nil || resp.Get() != nil {
+				t.Fatalf("#%d: expected only delete response, got %+v", i, resp)
+			}
+			if dresp.Deleted != 1 {
+				t.Errorf("#%d: deleted = %d, want 1", i, dresp.Deleted)
+			}
+		}
+	}
+}
+
+func TestKVDeleteRange(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 3})
+	defer clus.Terminate(t)
+
+	kv := clientv3.NewKV(clus.RandClient())
+	ctx := context.TODO()
+
+	tests := []struct {
+		key  string
+		opts []clientv3.OpOption
+
+		wkeys []string
+	}{
+		// [a, c)
+		{
+			key:  "a",
+			opts: []clientv3.OpOption{clientv3.WithRange("c")},
+
+			wkeys: []string{"c", "c/abc", "d"},
+		},
+		// >= c
+		{
+			key:  "c",
+			opts: []clientv3.OpOption{clientv3.WithFromKey()},
+
+			wkeys: []string{"a", "b"},
+		},
+		// c*
+		{
+			key:  "c",
+			opts: []clientv3.OpOption{clientv3.WithPrefix()},
+
+			wkeys: []string{"a", "b", "d"},
+		},
+		// *
+		{
+			key:  "\x00",
+			opts: []clientv3.OpOption{clientv3.WithFromKey()},
+
+			wkeys: []string{},
+		},
+	}
+
+	for i, tt := range tests {
+		keySet := []string{"a", "b", "c", "c/abc", "d"}
+		for j, key := range keySet {
+			if _, err := kv.Put(ctx, key, ""); err != nil {
+				t.Fatalf("#%d: couldn't put %q (%v)", j, key, err)
+			}
+		}
+
+		_, err := kv.Delete(ctx, tt.key, tt.opts...)
+		if err != nil {
+			t.Fatalf("#%d: couldn't delete range (%v)", i, err)
+		}
+
+		resp, err := kv.Get(ctx, "a", clientv3.WithFromKey())
+		if err != nil {
+			t.Fatalf("#%d: couldn't get keys (%v)", i, err)
+		}
+		keys := []string{}
+		for _, kv := range resp.Kvs {
+			keys = append(keys, string(kv.Key))
+		}
+		if !reflect.DeepEqual(tt.wkeys, keys) {
+			t.Errorf("#%d: resp.Kvs got %v, expected %v", i, keys, tt.wkeys)
+		}
+	}
+}
+
+func TestKVDeleteRangeWithPrevKV(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	kv := clientv3.NewKV(clus.RandClient())
+	ctx := context.TODO()
+
+	for _, k := range []string{"a", "b", "c"} {
+		if _, err := kv.Put(ctx, k, k+"v"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		key  string
+		opts []clientv3.OpOption
+
+		wkvs []string
+	}{
+		{"x", nil, nil},
+		{"a", nil, []string{"a=av"}},
+		{"a", []clientv3.OpOption{clientv3.WithFromKey()}, []string{"b=bv", "c=cv"}},
+	}
+	for i, tt := range tests {
+		opts := append(tt.opts, clientv3.WithPrevKV())
+		resp, err := kv.Delete(ctx, tt.key, opts...)
+		if err != nil {
+			t.Fatalf("#%d: couldn't delete (%v)", i, err)
+		}
+		var kvs []string
+		for _, pkv := range resp.PrevKvs {
+			kvs = append(kvs, string(pkv.Key)+"="+string(pkv.Value))
+		}
+		if !reflect.DeepEqual(kvs, tt.wkvs) {
+			t.Errorf("#%d: prev kvs = %v, want %v", i, kvs, tt.wkvs)
+		}
+		if resp.Deleted != int64(len(tt.wkvs)) {
+			t.Errorf("#%d: deleted = %d, want %d", i, resp.Deleted, len(tt.wkvs))
+		}
+	}
+}
+
+func TestKVDeleteAll(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	kv := clientv3.NewKV(clus.RandClient())
+	ctx := context.TODO()
+
+	for _, k := range []string{"fo", "foo/a", "foo/b", "fop"} {
+		if _, err := kv.Put(ctx, k, ""); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if _, err := clientv3.DeleteAll(ctx, kv, ""); err != clientv3.ErrEmptyPrefix {
+		t.Fatalf("err = %v, want %v", err, clientv3.ErrEmptyPrefix)
+	}
+	n, err := clientv3.DeleteAll(ctx, kv, "foo/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 2 {
+		t.Errorf("deleted = %d, want 2", n)
+	}
+	if n, err = clientv3.DeleteAll(ctx, kv, "foo/"); err != nil || n != 0 {
+		t.Errorf("deleted = %d, %v, want 0, <nil>", n, err)
+	}
+
+	resp, err := kv.Get(ctx, "f", clientv3.WithPrefix(), clientv3.WithCountOnly())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Count != 2 {
+		t.Errorf("count = %d, want 2", resp.Count)
+	}
+}
+
+func TestKVDelete(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 3})
+	defer clus.Terminate(t)
+
+	kv := clientv3.NewKV(clus.RandClient())
+	ctx := context.TODO()
+
+	presp, err := kv.Put(ctx, "foo", "")
+	if err != nil {
+		t.Fatalf("couldn't put 'foo' (%v)", err)
+	}
+	if presp.Header.Revision != 2 {
+		t.Fatalf("presp.Header.Revision got %d, want %d", presp.Header.Revision, 2)
+	}
+	resp, err := kv.Delete(ctx, "foo")
+	if err != nil {
+		t.Fatalf("couldn't delete key (%v)", err)
+	}
+	if resp.Header.Revision != 3 {
+		t.Fatalf("resp.Header.Revision got %d, want %d", resp.Header.Revision, 3)
+	}
+	gresp, err := kv.Get(ctx, "foo")
+	if err != nil {
+		t.Fatalf("couldn't get key (%v)", err)
+	}
+	if len(gresp.Kvs) > 0 {
+		t.Fatalf("gresp.Kvs got %+v, want none", gresp.Kvs)
+	}
+}
+
+func TestKVCompactError(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	kv := clientv3.NewKV(clus.RandClient())
+	ctx := context.TODO()
+
+	for i := 0; i < 5; i++ {
+		if _, err := kv.Put(ctx, "foo", "bar"); err != nil {
+			t.Fatalf("couldn't put 'foo' (%v)", err)
+		}
+	}
+	err := kv.Compact(ctx, 6)
+	if err != nil {
+		t.Fatalf("couldn't compact 6 (%v)", err)
+	}
+
+	// compacting at or below the compacted revision succeeds
+	for _, rev := range []int64{6, 3} {
+		if err = kv.Compact(ctx, rev); err != nil {
+			t.Fatalf("compact %d: expected no error, got %v", rev, err)
+		}
+	}
+
+	err = kv.Compact(ctx, 100)
+	if err != rpctypes.ErrFutureRev {
+		t.Fatalf("expected %v, got %v", rpctypes.ErrFutureRev, err)
+	}
+}
+
+func TestKVCompactTo(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	kv := clientv3.NewKV(clus.RandClient())
+	ctx := context.TODO()
+
+	// revision 1 only; not enough history
+	if err := clientv3.CompactTo(ctx, kv, 5); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 9; i++ {
+		if _, err := kv.Put(ctx, "foo", fmt.Sprintf("%d", i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// current revision is 10
+	if err := clientv3.CompactTo(ctx, kv, 5); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := kv.Get(ctx, "foo", clientv3.WithRev(4)); err != rpctypes.ErrCompacted {
+		t.Errorf("err = %v, want %v", err, rpctypes.ErrCompacted)
+	}
+	if _, err := kv.Get(ctx, "foo", clientv3.WithRev(5)); err != nil {
+		t.Errorf("couldn't get at the first kept revision (%v)", err)
+	}
+	// nothing new to compact
+	if err := clientv3.CompactTo(ctx, kv, 5); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestKVExists(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	kv := clientv3.NewKV(clus.RandClient())
+	ctx := context.TODO()
+
+	if _, err := kv.Put(ctx, "foo", "bar"); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		key  string
+		opts []clientv3.OpOption
+		wok  bool
+	}{
+		{"foo", nil, true},
+		{"fo", nil, false},
+		{"fo", []clientv3.OpOption{clientv3.WithPrefix()}, true},
+		{"foo", []clientv3.OpOption{clientv3.WithSerializable()}, true},
+		{"bar", []clientv3.OpOption{clientv3.WithSerializable()}, false},
+	}
+	for i, tt := range tests {
+		ok, err := clientv3.Exists(ctx, kv, tt.key, tt.opts...)
+		if err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+		if ok != tt.wok {
+			t.Errorf("#%d: exists = %v, want %v", i, ok, tt.wok)
+		}
+	}
+}
+
+func TestKVJSON(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	kv := clientv3.NewKV(clus.RandClient())
+	ctx := context.TODO()
+
+	type config struct {
+		Name  string
+		Ports []int
+	}
+	var got config
+	if err := clientv3.GetJSON(ctx, kv, "cfg", &got); err != rpctypes.ErrKeyNotFound {
+		t.Fatalf("err = %v, want %v", err, rpctypes.ErrKeyNotFound)
+	}
+
+	want := config{Name: "foo", Ports: []int{2379, 2380}}
+	if _, err := clientv3.PutJSON(ctx, kv, "cfg", want); err != nil {
+		t.Fatal(err)
+	}
+	resp, err := kv.Get(ctx, "cfg")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if wv := `{"Name":"foo","Ports":[2379,2380]}`; string(resp.Kvs[0].Value) != wv {
+		t.Errorf("value = %q, want %q", resp.Kvs[0].Value, wv)
+	}
+	if err := clientv3.GetJSON(ctx, kv, "cfg", &got); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+
+	if _, err := kv.Put(ctx, "cfg", "not json"); err != nil {
+		t.Fatal(err)
+	}
+	if err := clientv3.GetJSON(ctx, kv, "cfg", &got); err == nil {
+		t.Errorf("expected error decoding a non-JSON value")
+	}
+}
+
+func TestKVPutRev(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	kv := clientv3.NewKV(clus.RandClient())
+	ctx := context.TODO()
+
+	for i := 0; i < 3; i++ {
+		rev, err := clientv3.PutRev(ctx, kv, "foo", "bar")
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp, err := kv.Get(ctx, "foo")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if mrev := resp.Kvs[0].ModRevision; rev != mrev {
+			t.Errorf("#%d: rev = %d, want mod revision %d", i, rev, mrev)
+		}
+	}
+}
+
+func TestKVDoOnce(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	kv := clientv3.NewKV(clus.RandClient())
+	ctx := context.TODO()
+
+	tests := []struct {
+		id string
+		op clientv3.Op
+
+		wapplied bool
+		wvals    []string
+	}{
+		{"req1", clientv3.OpPut("foo", "1"), true, []string{"1"}},
+		// a retry of req1 is not applied again
+		{"req1", clientv3.OpPut("foo", "2"), false, []string{"1"}},
+		{"req2", clientv3.OpPut("foo", "2"), true, []string{"2"}},
+		{"req3", clientv3.OpDelete("foo"), true, []string{}},
+		{"req2", clientv3.OpPut("foo", "2"), false, []string{}},
+	}
+	for i, tt := range tests {
+		resp, applied, err := clientv3.DoOnce(ctx, kv, tt.id, tt.op)
+		if err != nil {
+			t.Fatalf("#%d: couldn't do op (%v)", i, err)
+		}
+		if applied != tt.wapplied {
+			t.Errorf("#%d: applied = %v, want %v", i, applied, tt.wapplied)
+		}
+		if applied != (resp.Header() != nil) {
+			t.Errorf("#%d: unexpected response %+v", i, resp)
+		}
+		gresp, err := kv.Get(ctx, "foo")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if vals := gresp.Values(); !reflect.DeepEqual(vals, tt.wvals) {
+			t.Errorf("#%d: values = %v, want %v", i, vals, tt.wvals)
+		}
+	}
+
+	resp, err := kv.Get(ctx, clientv3.DoOncePrefix, clientv3.WithPrefix(), clientv3.WithCountOnly())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Count != 3 {
+		t.Errorf("marker count = %d, want 3", resp.Count)
+	}
+}
+
+func TestKVCompact(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 3})
+	defer clus.Terminate(t)
+
+	kv := clientv3.NewKV(clus.RandClient())
+	ctx := context.TODO()
+
+	for i := 0; i < 10; i++ {
+		if _, err := kv.Put(ctx, "foo", "bar"); err != nil {
+			t.Fatalf("couldn't put 'foo' (%v)", err)
+		}
+	}
+
+	err := kv.Compact(ctx, 7, clientv3.WithCompactPhysical())
+	if err != nil {
+		t.Fatalf("couldn't compact kv space (%v)", err)
+	}
+	if err = kv.Compact(ctx, 7); err != nil {
+		t.Fatalf("couldn't compact compacted kv space (%v)", err)
+	}
+
+	wcli := clus.RandClient()
+	// new watcher could precede receiving the compaction without quorum first
+	wcli.Get(ctx, "quorum-get")
+
+	wc := clientv3.NewWatcher(wcli)
+	defer wc.Close()
+	wchan := wc.Watch(ctx, "foo", clientv3.WithRev(3))
+
+	if wr := <-wchan; wr.CompactRevision != 7 {
+		t.Fatalf("wchan CompactRevision got %v, want 7", wr.CompactRevision)
+	}
+	if wr, ok := <-wchan; ok {
+		t.Fatalf("wchan got %v, expected closed", wr)
+	}
+
+	err = kv.Compact(ctx, 1000)
+	if err == nil || err != rpctypes.ErrFutureRev {
+		t.Fatalf("error got %v, want %v", err, rpctypes.ErrFutureRev)
+	}
+}
+
+// TestKVGetRetry ensures get will retry on disconnect.
+func TestKVGetRetry(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 3})
+	defer clus.Terminate(t)
+
+	kv := clientv3.NewKV(clus.Client(0))
+	ctx := context.TODO()
+
+	if _, err := kv.Put(ctx, "foo", "bar"); err != nil {
+		t.Fatal(err)
 	}
 
 	clus.Members[0].Stop(t)
@@ -507,6 +1381,349 @@ func TestKVGetRetry(t *testing.T) {
 	}
 }
 
+// TestKVGetEndpointFailover ensures a get fails over to another endpoint
+// once the member the client is connected to goes down.
+func TestKVGetEndpointFailover(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 3})
+	defer clus.Terminate(t)
+
+	eps := make([]string, len(clus.Members))
+	for i, m := range clus.Members {
+		eps[i] = m.GRPCAddr()
+	}
+	cli, err := clientv3.New(clientv3.Config{Endpoints: eps, DialTimeout: 5 * time.Second})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cli.Close()
+
+	if _, err = cli.Put(context.TODO(), "foo", "bar"); err != nil {
+		t.Fatal(err)
+	}
+
+	// the client is connected to the first endpoint; take it down
+	clus.Members[0].Stop(t)
+	defer clus.Members[0].Restart(t)
+
+	donec := make(chan struct{})
+	go func() {
+		defer close(donec)
+		gresp, gerr := cli.Get(context.TODO(), "foo")
+		if gerr != nil {
+			t.Errorf("expected get to fail over to another endpoint, got %v", gerr)
+			return
+		}
+		if len(gresp.Kvs) != 1 || string(gresp.Kvs[0].Value) != "bar" {
+			t.Errorf("kvs = %+v, want foo=bar", gresp.Kvs)
+		}
+	}()
+	select {
+	case <-time.After(10 * time.Second):
+		t.Fatalf("timed out waiting for get")
+	case <-donec:
+	}
+}
+
+// TestClientConnState ensures ConnState follows the client to the endpoint
+// it fails over to.
+func TestClientConnState(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 3})
+	defer clus.Terminate(t)
+
+	eps := []string{clus.Members[0].GRPCAddr(), clus.Members[1].GRPCAddr()}
+	cli, err := clientv3.New(clientv3.Config{Endpoints: eps, DialTimeout: 5 * time.Second})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = cli.Put(context.TODO(), "foo", "bar"); err != nil {
+		t.Fatal(err)
+	}
+	if st, ep := cli.ConnState(); st != grpc.Ready || ep != eps[0] {
+		t.Fatalf("state, endpoint = %v, %q, want %v, %q", st, ep, grpc.Ready, eps[0])
+	}
+
+	clus.Members[0].Stop(t)
+	defer clus.Members[0].Restart(t)
+	if _, err = cli.Get(context.TODO(), "foo"); err != nil {
+		t.Fatal(err)
+	}
+	if st, ep := cli.ConnState(); st != grpc.Ready || ep != eps[1] {
+		t.Fatalf("state, endpoint = %v, %q, want %v, %q", st, ep, grpc.Ready, eps[1])
+	}
+
+	cli.Close()
+	if st, ep := cli.ConnState(); st != grpc.Shutdown || ep != "" {
+		t.Errorf("state, endpoint = %v, %q, want %v, %q", st, ep, grpc.Shutdown, "")
+	}
+}
+
+// TestClientWaitReady ensures WaitReady moves past a down member and
+// gives up when ctx is done while the cluster has no quorum.
+func TestClientWaitReady(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 3})
+	defer clus.Terminate(t)
+
+	eps := []string{clus.Members[0].GRPCAddr(), clus.Members[1].GRPCAddr()}
+	cli, err := clientv3.New(clientv3.Config{Endpoints: eps, DialTimeout: 5 * time.Second})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cli.Close()
+
+	clus.Members[0].Stop(t)
+	defer clus.Members[0].Restart(t)
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 10*time.Second)
+	err = cli.WaitReady(ctx)
+	cancel()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ep := cli.ConnState(); ep != eps[1] {
+		t.Fatalf("endpoint = %q, want %q", ep, eps[1])
+	}
+
+	// one member left cannot serve a linearizable read
+	clus.Members[2].Stop(t)
+	defer clus.Members[2].Restart(t)
+
+	ctx, cancel = context.WithTimeout(context.TODO(), time.Second)
+	err = cli.WaitReady(ctx)
+	cancel()
+	if err != context.DeadlineExceeded {
+		t.Fatalf("err = %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+type attemptCounter struct {
+	mu       sync.Mutex
+	attempts int
+}
+
+func (ac *attemptCounter) Start(ctx context.Context, info clientv3.RequestInfo) context.Context {
+	ac.mu.Lock()
+	ac.attempts++
+	ac.mu.Unlock()
+	return ctx
+}
+
+func (ac *attemptCounter) End(context.Context, clientv3.RequestInfo, error) {}
+
+// TestKVGetCancelDuringRetry ensures a get canceled while retrying on a
+// down member returns promptly without issuing more attempts.
+func TestKVGetCancelDuringRetry(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	ac := &attemptCounter{}
+	cfg := clientv3.Config{
+		Endpoints:   []string{clus.Members[0].GRPCAddr()},
+		DialTimeout: 5 * time.Second,
+		RequestHook: ac,
+	}
+	cli, err := clientv3.New(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cli.Close()
+
+	clus.Members[0].Stop(t)
+
+	ctx, cancel := context.WithCancel(context.TODO())
+	errc := make(chan error, 1)
+	go func() {
+		_, gerr := cli.Get(ctx, "foo")
+		errc <- gerr
+	}()
+	time.Sleep(500 * time.Millisecond)
+	cancel()
+
+	select {
+	case err = <-errc:
+		if err != context.Canceled {
+			t.Fatalf("expected %v, got %v", context.Canceled, err)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("timed out waiting for canceled get")
+	}
+
+	// a canceled context issues no attempts at all
+	ac.mu.Lock()
+	ac.attempts = 0
+	ac.mu.Unlock()
+	if _, err = cli.Get(ctx, "foo"); err != context.Canceled {
+		t.Fatalf("expected %v, got %v", context.Canceled, err)
+	}
+	ac.mu.Lock()
+	defer ac.mu.Unlock()
+	if ac.attempts != 0 {
+		t.Fatalf("expected no attempts, got %d", ac.attempts)
+	}
+}
+
+// TestKVGetConcurrentReconnect ensures concurrent gets keep succeeding while
+// the members they are connected to go down and come back. Run with -race to
+// check remote swaps under load.
+func TestKVGetConcurrentReconnect(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 3})
+	defer clus.Terminate(t)
+
+	eps := make([]string, len(clus.Members))
+	for i, m := range clus.Members {
+		eps[i] = m.GRPCAddr()
+	}
+	cli, err := clientv3.New(clientv3.Config{Endpoints: eps, DialTimeout: 5 * time.Second})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cli.Close()
+
+	if _, err = cli.Put(context.TODO(), "foo", "bar"); err != nil {
+		t.Fatal(err)
+	}
+
+	getters := 20
+	stopc := make(chan struct{})
+	errc := make(chan error, getters)
+	for i := 0; i < getters; i++ {
+		go func() {
+			for {
+				select {
+				case <-stopc:
+					errc <- nil
+					return
+				default:
+				}
+				ctx, cancel := context.WithTimeout(context.TODO(), 10*time.Second)
+				resp, gerr := cli.Get(ctx, "foo")
+				cancel()
+				if gerr == nil && (len(resp.Kvs) != 1 || string(resp.Kvs[0].Value) != "bar") {
+					gerr = fmt.Errorf("kvs = %+v, want foo=bar", resp.Kvs)
+				}
+				if gerr != nil {
+					errc <- gerr
+					return
+				}
+			}
+		}()
+	}
+
+	// flap each member in turn, keeping quorum
+	for _, m := range clus.Members {
+		m.Stop(t)
+		time.Sleep(200 * time.Millisecond)
+		m.Restart(t)
+		time.Sleep(200 * time.Millisecond)
+	}
+	close(stopc)
+
+	for i := 0; i < getters; i++ {
+		select {
+		case err := <-errc:
+			if err != nil {
+				t.Errorf("get failed: %v", err)
+			}
+		case <-time.After(15 * time.Second):
+			t.Fatalf("timed out waiting for gets")
+		}
+	}
+}
+
+// TestKVHealthCheckReconnect ensures the health check replaces a dead
+// connection before the next request is issued on it.
+func TestKVHealthCheckReconnect(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 3})
+	defer clus.Terminate(t)
+
+	eps := make([]string, len(clus.Members))
+	for i, m := range clus.Members {
+		eps[i] = m.GRPCAddr()
+	}
+	cfg := clientv3.Config{
+		Endpoints:           eps,
+		DialTimeout:         5 * time.Second,
+		HealthCheckInterval: 100 * time.Millisecond,
+		// fail on the first error; only the health check may reconnect
+		RetryPolicy: &clientv3.RetryPolicy{MaxAttempts: 1},
+	}
+	cli, err := clientv3.New(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cli.Close()
+
+	if _, err = cli.Put(context.TODO(), "foo", "bar"); err != nil {
+		t.Fatal(err)
+	}
+
+	// the client is connected to the first endpoint; take it down
+	conn := cli.ActiveConnection()
+	clus.Members[0].Stop(t)
+	defer clus.Members[0].Restart(t)
+
+	for i := 0; ; i++ {
+		if c := cli.ActiveConnection(); c != nil && c != conn {
+			if st, _ := c.State(); st == grpc.Ready {
+				break
+			}
+		}
+		// other reconnect paths (e.g., the lease stream) take several seconds
+		if i == 20 {
+			t.Fatal("expected health check to replace the connection")
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	if _, err = cli.Get(context.TODO(), "foo"); err != nil {
+		t.Fatalf("expected get on the new connection to succeed, got %v", err)
+	}
+}
+
+func TestKVGetRequestTimeout(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 3})
+	defer clus.Terminate(t)
+
+	cli, err := clientv3.New(clientv3.Config{
+		Endpoints:      []string{clus.Members[0].GRPCAddr()},
+		DialTimeout:    5 * time.Second,
+		RequestTimeout: 500 * time.Millisecond,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cli.Close()
+
+	// linearizable reads block without quorum
+	clus.Members[1].Stop(t)
+	clus.Members[2].Stop(t)
+
+	start := time.Now()
+	if _, err = cli.Get(context.Background(), "foo"); err != context.DeadlineExceeded {
+		t.Fatalf("err = %v, want %v", err, context.DeadlineExceeded)
+	}
+	if took := time.Since(start); took > 3*time.Second {
+		t.Fatalf("get took %v, expected timeout after 500ms", took)
+	}
+	if err = cli.Compact(context.Background(), 1); err != context.DeadlineExceeded {
+		t.Fatalf("compact err = %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
 // TestKVPutFailGetRetry ensures a get will retry following a failed put.
 func TestKVPutFailGetRetry(t *testing.T) {
 	defer testutil.AfterTest(t)
@@ -586,3 +1803,108 @@ func TestKVPutStoppedServerAndClose(t *testing.T) {
 	}
 	// cluster will terminate and close the client with the retry in-flight
 }
+
+// TestKVAfterClose ensures requests on a closed client fail with
+// ErrClientClosed instead of blocking or panicking.
+func TestKVAfterClose(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	cli, err := clientv3.New(clientv3.Config{Endpoints: []string{clus.Members[0].GRPCAddr()}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	kv := clientv3.NewKV(cli)
+	if _, err := kv.Put(context.TODO(), "foo", "bar"); err != nil {
+		t.Fatal(err)
+	}
+	if err := cli.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := cli.Close(); err != nil {
+		t.Errorf("second close err = %v, want nil", err)
+	}
+
+	donec := make(chan struct{})
+	go func() {
+		defer close(donec)
+		if _, err := kv.Get(context.TODO(), "foo"); err != clientv3.ErrClientClosed {
+			t.Errorf("get err = %v, want %v", err, clientv3.ErrClientClosed)
+		}
+		if _, err := cli.Put(context.TODO(), "foo", "bar"); err != clientv3.ErrClientClosed {
+			t.Errorf("put err = %v, want %v", err, clientv3.ErrClientClosed)
+		}
+		if _, err := clientv3.NewKV(cli).Get(context.TODO(), "foo"); err != clientv3.ErrClientClosed {
+			t.Errorf("get on new kv err = %v, want %v", err, clientv3.ErrClientClosed)
+		}
+		if _, err := cli.Txn(context.TODO()).Then(clientv3.OpGet("foo")).Commit(); err != clientv3.ErrClientClosed {
+			t.Errorf("txn err = %v, want %v", err, clientv3.ErrClientClosed)
+		}
+	}()
+	select {
+	case <-time.After(5 * time.Second):
+		t.Fatal("requests on closed client took too long")
+	case <-donec:
+	}
+}
+
+// TestKVReadBalancer ensures serializable reads are spread over the
+// endpoints and move off a stopped member.
+func TestKVReadBalancer(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 3})
+	defer clus.Terminate(t)
+
+	eps := []string{clus.Members[0].GRPCAddr(), clus.Members[1].GRPCAddr(), clus.Members[2].GRPCAddr()}
+	cli, err := clientv3.New(clientv3.Config{Endpoints: eps, ReadBalancer: clientv3.NewRoundRobinBalancer()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cli.Close()
+
+	if _, err := cli.Put(context.TODO(), "foo", "bar"); err != nil {
+		t.Fatal(err)
+	}
+	// wait for the put to reach all members
+	if _, err := cli.Get(context.TODO(), "foo"); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(100 * time.Millisecond)
+
+	members := func(n int) map[uint64]int {
+		ids := make(map[uint64]int)
+		for i := 0; i < n; i++ {
+			resp, err := cli.Get(context.TODO(), "foo", clientv3.WithSerializable())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(resp.Kvs) != 1 {
+				t.Fatalf("expected 1 key, got %+v", resp.Kvs)
+			}
+			ids[resp.Header.MemberId]++
+		}
+		return ids
+	}
+	if ids := members(6); len(ids) != 3 {
+		t.Fatalf("reads served by %v, want all 3 members", ids)
+	}
+
+	resp, err := clus.Client(2).Get(context.TODO(), "foo", clientv3.WithSerializable())
+	if err != nil {
+		t.Fatal(err)
+	}
+	stopped := resp.Header.MemberId
+	clus.Members[2].Stop(t)
+	ids := members(6)
+	if _, ok := ids[stopped]; ok || len(ids) != 2 {
+		t.Fatalf("reads served by %v, want the 2 running members", ids)
+	}
+
+	// writes still go through the active connection
+	if _, err := cli.Put(context.TODO(), "foo", "baz"); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -15,6 +15,8 @@
 package integration
 
 import (
+	"reflect"
+	"sort"
 	"testing"
 	"time"
 
@@ -64,6 +66,165 @@ func TestLeaseGrant(t *testing.T) {
 	}
 }
 
+func TestLeaseOf(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	lapi := clientv3.NewLease(clus.RandClient())
+	defer lapi.Close()
+
+	kv := clientv3.NewKV(clus.RandClient())
+
+	resp, err := lapi.Grant(context.Background(), 10)
+	if err != nil {
+		t.Fatalf("failed to create lease %v", err)
+	}
+	if _, err = kv.Put(context.TODO(), "foo", "bar", clientv3.WithLease(resp.ID)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = kv.Put(context.TODO(), "abc", "bar"); err != nil {
+		t.Fatal(err)
+	}
+
+	gresp, err := kv.Get(context.TODO(), "", clientv3.WithPrefix())
+	if err != nil {
+		t.Fatal(err)
+	}
+	wleases := map[string]clientv3.LeaseID{"abc": clientv3.NoLease, "foo": resp.ID}
+	for _, ev := range gresp.Kvs {
+		if id := clientv3.LeaseOf(ev); id != wleases[string(ev.Key)] {
+			t.Errorf("lease of %q = %x, want %x", ev.Key, id, wleases[string(ev.Key)])
+		}
+	}
+	if id := clientv3.LeaseOf(nil); id != clientv3.NoLease {
+		t.Errorf("lease of nil = %x, want none", id)
+	}
+}
+
+// TestLeaseTimeToLive ensures every member reports the remaining TTL and
+// attached keys of a lease, and a revoked lease by its TTL.
+func TestLeaseTimeToLive(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 3})
+	defer clus.Terminate(t)
+
+	lapi := clientv3.NewLease(clus.RandClient())
+	defer lapi.Close()
+
+	resp, err := lapi.Grant(context.Background(), 10)
+	if err != nil {
+		t.Fatalf("failed to create lease %v", err)
+	}
+
+	kv := clientv3.NewKV(clus.RandClient())
+	keys := []string{"foo1", "foo2"}
+	for i := range keys {
+		if _, err = kv.Put(context.TODO(), keys[i], "bar", clientv3.WithLease(resp.ID)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// followers forward the request to the leader
+	for i := 0; i < 3; i++ {
+		mapi := clientv3.NewLease(clus.Client(i))
+		lresp, lerr := mapi.TimeToLive(context.Background(), resp.ID, clientv3.WithAttachedKeys())
+		mapi.Close()
+		if lerr != nil {
+			t.Fatalf("#%d: %v", i, lerr)
+		}
+		if lresp.ResponseHeader == nil {
+			t.Fatalf("#%d: expected header", i)
+		}
+		if lresp.ID != resp.ID {
+			t.Errorf("#%d: ID = %x, want %x", i, lresp.ID, resp.ID)
+		}
+		if lresp.GrantedTTL != 10 {
+			t.Errorf("#%d: granted TTL = %d, want 10", i, lresp.GrantedTTL)
+		}
+		if lresp.TTL <= 0 || lresp.TTL > 10 {
+			t.Errorf("#%d: TTL = %d, want in (0, 10]", i, lresp.TTL)
+		}
+		var ks []string
+		for _, k := range lresp.Keys {
+			ks = append(ks, string(k))
+		}
+		sort.Strings(ks)
+		if !reflect.DeepEqual(ks, keys) {
+			t.Errorf("#%d: keys = %v, want %v", i, ks, keys)
+		}
+	}
+
+	lresp, err := lapi.TimeToLive(context.Background(), resp.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(lresp.Keys) != 0 {
+		t.Errorf("keys = %q, want none without WithAttachedKeys", lresp.Keys)
+	}
+
+	if _, err = lapi.Revoke(context.Background(), resp.ID); err != nil {
+		t.Fatalf("failed to revoke lease %v", err)
+	}
+	for i := 0; i < 3; i++ {
+		mapi := clientv3.NewLease(clus.Client(i))
+		lresp, err = mapi.TimeToLive(context.Background(), resp.ID)
+		mapi.Close()
+		if err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+		if lresp.TTL > 0 {
+			t.Errorf("#%d: TTL = %d, want expired lease", i, lresp.TTL)
+		}
+	}
+}
+
+func TestLeaseLeases(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	lapi := clientv3.NewLease(clus.RandClient())
+	defer lapi.Close()
+
+	ids := make(map[clientv3.LeaseID]bool)
+	for i := 0; i < 5; i++ {
+		resp, err := lapi.Grant(context.Background(), 10)
+		if err != nil {
+			t.Fatalf("failed to create lease %v", err)
+		}
+		ids[resp.ID] = true
+	}
+
+	resp, err := lapi.Leases(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Leases) != len(ids) {
+		t.Fatalf("len(leases) = %d, want %d", len(resp.Leases), len(ids))
+	}
+	for _, l := range resp.Leases {
+		if !ids[l.ID] {
+			t.Errorf("unexpected lease %x", l.ID)
+		}
+	}
+
+	for id := range ids {
+		if _, err = lapi.Revoke(context.Background(), id); err != nil {
+			t.Fatalf("failed to revoke lease %v", err)
+		}
+	}
+	if resp, err = lapi.Leases(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Leases) != 0 {
+		t.Errorf("leases = %+v, want none after revoke", resp.Leases)
+	}
+}
+
 func TestLeaseRevoke(t *testing.T) {
 	defer testutil.AfterTest(t)
 
@@ -91,6 +252,39 @@ func TestLeaseRevoke(t *testing.T) {
 	}
 }
 
+func TestLeaseGrantRevokeAfterClose(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	lapi := clientv3.NewLease(clus.RandClient())
+	resp, err := lapi.Grant(context.Background(), 10)
+	if err != nil {
+		t.Fatalf("failed to create lease %v", err)
+	}
+	lapi.Close()
+
+	donec := make(chan struct{})
+	go func() {
+		if _, err := lapi.Grant(context.Background(), 10); err == nil {
+			t.Errorf("expected error on grant after close")
+		}
+		if _, err := lapi.Revoke(context.Background(), resp.ID); err == nil {
+			t.Errorf("expected error on revoke after close")
+		}
+		if _, err := lapi.KeepAliveOnce(context.Background(), resp.ID); err == nil {
+			t.Errorf("expected error on keepalive after close")
+		}
+		close(donec)
+	}()
+	select {
+	case <-donec:
+	case <-time.After(3 * time.Second):
+		t.Fatal("lease requests took too long after close")
+	}
+}
+
 func TestLeaseKeepAliveOnce(t *testing.T) {
 	defer testutil.AfterTest(t)
 
@@ -114,6 +308,14 @@ func TestLeaseKeepAliveOnce(t *testing.T) {
 	if err != rpctypes.ErrLeaseNotFound {
 		t.Errorf("expected %v, got %v", rpctypes.ErrLeaseNotFound, err)
 	}
+
+	if _, err = lapi.Revoke(context.Background(), resp.ID); err != nil {
+		t.Fatalf("failed to revoke lease %v", err)
+	}
+	_, err = lapi.KeepAliveOnce(context.Background(), resp.ID)
+	if err != rpctypes.ErrLeaseNotFound {
+		t.Errorf("expected %v on revoked lease, got %v", rpctypes.ErrLeaseNotFound, err)
+	}
 }
 
 func TestLeaseKeepAlive(t *testing.T) {
@@ -153,6 +355,43 @@ func TestLeaseKeepAlive(t *testing.T) {
 
 // TODO: add a client that can connect to all the members of cluster via unix sock.
 // TODO: test handle more complicated failures.
+// TestLeaseKeepAliveTTLTimeout ensures the keep alive channel closes if
+// no keep alive response arrives within the lease TTL.
+func TestLeaseKeepAliveTTLTimeout(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	lapi := clientv3.NewLease(clus.Client(0))
+	defer lapi.Close()
+
+	resp, err := lapi.Grant(context.Background(), 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rc, kerr := lapi.KeepAlive(context.Background(), resp.ID)
+	if kerr != nil {
+		t.Fatal(kerr)
+	}
+	if kresp := <-rc; kresp.ID != resp.ID {
+		t.Fatalf("ID = %x, want %x", kresp.ID, resp.ID)
+	}
+
+	// keep client disconnected
+	clus.Members[0].Stop(t)
+	select {
+	case ka, ok := <-rc:
+		if ok {
+			t.Fatalf("unexpected keepalive %v, expected closed channel", ka)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatalf("keepalive channel did not close")
+	}
+
+	clus.Members[0].Restart(t)
+}
+
 func TestLeaseKeepAliveHandleFailure(t *testing.T) {
 	t.Skip("test it when we have a cluster client")
 
@@ -0,0 +1,250 @@
+// Copyright 2016 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package integration
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/coreos/etcd/etcdserver/api/v3rpc/rpctypes"
+	pb "github.com/coreos/etcd/etcdserver/etcdserverpb"
+	"github.com/coreos/etcd/integration"
+	"github.com/coreos/etcd/pkg/testutil"
+	"github.com/coreos/etcd/version"
+	"golang.org/x/net/context"
+)
+
+// TestMaintenanceMoveLeader ensures that MoveLeader transfers the leadership
+// to the target member and is rejected by followers.
+func TestMaintenanceMoveLeader(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 3})
+	defer clus.Terminate(t)
+
+	oldLeadIdx := clus.WaitLeader(t)
+	targetIdx := (oldLeadIdx + 1) % 3
+
+	tcli := clus.Client(targetIdx)
+	sresp, err := tcli.Status(context.TODO(), tcli.Endpoints()[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	target := sresp.Header.MemberId
+
+	cli := clus.Client(oldLeadIdx)
+	if err = cli.MoveLeader(context.TODO(), target); err != nil {
+		t.Fatal(err)
+	}
+	if lead := clus.WaitLeader(t); lead != targetIdx {
+		t.Fatalf("new leader expected %d, got %d", targetIdx, lead)
+	}
+
+	// the old leader is now a follower
+	if err = cli.MoveLeader(context.TODO(), target); err != rpctypes.ErrNotLeader {
+		t.Fatalf("err = %v, want %v", err, rpctypes.ErrNotLeader)
+	}
+}
+
+// TestMaintenanceStatus ensures that Status reports each member's own view
+// of the cluster.
+func TestMaintenanceStatus(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 3})
+	defer clus.Terminate(t)
+
+	clus.WaitLeader(t)
+
+	eps := make([]string, 3)
+	for i := range eps {
+		eps[i] = clus.Members[i].GRPCAddr()
+	}
+	cli, err := clientv3.New(clientv3.Config{Endpoints: eps, DialTimeout: 5 * time.Second})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cli.Close()
+
+	ids := make(map[uint64]struct{})
+	var lead uint64
+	for i, ep := range eps {
+		resp, err := cli.Status(context.TODO(), ep)
+		if err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+		if resp.Version != version.Version {
+			t.Errorf("#%d: version = %q, want %q", i, resp.Version, version.Version)
+		}
+		if resp.DbSize == 0 || resp.RaftTerm == 0 {
+			t.Errorf("#%d: unexpected empty status %+v", i, resp)
+		}
+		if lead != 0 && resp.Leader != lead {
+			t.Errorf("#%d: leader = %x, want %x", i, resp.Leader, lead)
+		}
+		lead = resp.Leader
+		ids[resp.Header.MemberId] = struct{}{}
+	}
+	if len(ids) != 3 {
+		t.Errorf("got %d distinct members, want 3", len(ids))
+	}
+	if _, ok := ids[lead]; !ok {
+		t.Errorf("leader %x is not a member", lead)
+	}
+}
+
+// TestMaintenanceSnapshot ensures that Snapshot streams the backend followed
+// by its sha256 checksum.
+func TestMaintenanceSnapshot(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	cli := clus.RandClient()
+	for i := 0; i < 10; i++ {
+		if _, err := cli.Put(context.TODO(), fmt.Sprintf("foo%d", i), "bar"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	rc, err := cli.Snapshot(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rc.Close()
+
+	b, err := ioutil.ReadAll(rc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(b) <= sha256.Size {
+		t.Fatalf("snapshot size = %d, want > %d", len(b), sha256.Size)
+	}
+	db, sha := b[:len(b)-sha256.Size], b[len(b)-sha256.Size:]
+	if h := sha256.Sum256(db); !bytes.Equal(h[:], sha) {
+		t.Errorf("snapshot sha = %x, want %x", sha, h)
+	}
+}
+
+// TestMaintenanceSnapshotCancel ensures that canceling the context of a
+// snapshot stops the reader with the context error.
+func TestMaintenanceSnapshotCancel(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	rc, err := clus.RandClient().Snapshot(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rc.Close()
+
+	cancel()
+	if _, err = io.Copy(ioutil.Discard, rc); err != context.Canceled {
+		t.Errorf("err = %v, want %v", err, context.Canceled)
+	}
+}
+
+// TestMaintenanceDefragment ensures that Defragment reaches every given member.
+func TestMaintenanceDefragment(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 3})
+	defer clus.Terminate(t)
+
+	cli := clus.RandClient()
+	for i := 0; i < 10; i++ {
+		if _, err := cli.Put(context.TODO(), "foo", "bar"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := cli.Compact(context.TODO(), 10); err != nil {
+		t.Fatal(err)
+	}
+
+	for i := range clus.Members {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		_, err := cli.Defragment(ctx, clus.Members[i].GRPCAddr())
+		cancel()
+		if err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+	}
+
+	// an expired deadline gives up without waiting for the member
+	ctx, cancel := context.WithTimeout(context.Background(), time.Nanosecond)
+	defer cancel()
+	time.Sleep(time.Millisecond)
+	if _, err := cli.Defragment(ctx, clus.Members[0].GRPCAddr()); err == nil {
+		t.Errorf("expected error with expired deadline")
+	}
+}
+
+// TestMaintenanceAlarmDisarm ensures that an active NOSPACE alarm is listed
+// and that disarming it lets puts through again.
+func TestMaintenanceAlarmDisarm(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	cli := clus.RandClient()
+	sresp, err := cli.Status(context.TODO(), cli.Endpoints()[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	id := sresp.Header.MemberId
+
+	mc := pb.NewMaintenanceClient(cli.ActiveConnection())
+	areq := &pb.AlarmRequest{Action: pb.AlarmRequest_ACTIVATE, MemberID: id, Alarm: pb.AlarmType_NOSPACE}
+	if _, err = mc.Alarm(context.TODO(), areq); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = cli.Put(context.TODO(), "foo", "bar"); err != rpctypes.ErrNoSpace {
+		t.Fatalf("err = %v, want %v", err, rpctypes.ErrNoSpace)
+	}
+
+	aresp, err := cli.AlarmList(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+	wam := &pb.AlarmMember{MemberID: id, Alarm: pb.AlarmType_NOSPACE}
+	if len(aresp.Alarms) != 1 || !reflect.DeepEqual(aresp.Alarms[0], wam) {
+		t.Fatalf("alarms = %v, want [%v]", aresp.Alarms, wam)
+	}
+
+	if _, err = cli.AlarmDisarm(context.TODO(), &clientv3.AlarmMember{}); err != nil {
+		t.Fatal(err)
+	}
+	if aresp, err = cli.AlarmList(context.TODO()); err != nil {
+		t.Fatal(err)
+	}
+	if len(aresp.Alarms) != 0 {
+		t.Fatalf("alarms = %v, want none", aresp.Alarms)
+	}
+	if _, err = cli.Put(context.TODO(), "foo", "bar"); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,173 @@
+// Copyright 2016 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package integration
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/coreos/etcd/clientv3/namespace"
+	"github.com/coreos/etcd/integration"
+	"github.com/coreos/etcd/pkg/testutil"
+	"golang.org/x/net/context"
+)
+
+func TestNamespacePutGet(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	c := clus.Client(0)
+	nsKV := namespace.NewKV(c.KV, "foo/")
+
+	if _, err := nsKV.Put(context.TODO(), "abc", "bar"); err != nil {
+		t.Fatal(err)
+	}
+	resp, err := nsKV.Get(context.TODO(), "abc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(resp.Kvs[0].Key) != "abc" {
+		t.Errorf("expected key=%q, got key=%q", "abc", resp.Kvs[0].Key)
+	}
+
+	resp, err = c.Get(context.TODO(), "foo/abc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(resp.Kvs[0].Value) != "bar" {
+		t.Errorf("expected value=%q, got value=%q", "bar", resp.Kvs[0].Value)
+	}
+
+	presp, err := nsKV.Put(context.TODO(), "abc", "baz", clientv3.WithPrevKV())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if presp.PrevKv == nil || string(presp.PrevKv.Key) != "abc" {
+		t.Errorf("expected prev key=%q, got %+v", "abc", presp.PrevKv)
+	}
+	dresp, err := nsKV.Delete(context.TODO(), "abc", clientv3.WithPrevKV())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(dresp.PrevKvs) != 1 || string(dresp.PrevKvs[0].Key) != "abc" {
+		t.Errorf("expected prev key=%q, got %+v", "abc", dresp.PrevKvs)
+	}
+}
+
+func TestNamespaceRange(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	c := clus.Client(0)
+	for _, k := range []string{"fo", "foo/", "foo/a", "foo/b", "fop"} {
+		if _, err := c.Put(context.TODO(), k, ""); err != nil {
+			t.Fatal(err)
+		}
+	}
+	nsKV := namespace.NewKV(c.KV, "foo/")
+
+	tests := []struct {
+		key  string
+		opts []clientv3.OpOption
+
+		wkeys []string
+	}{
+		{"a", []clientv3.OpOption{clientv3.WithPrefix()}, []string{"a"}},
+		{"", []clientv3.OpOption{clientv3.WithPrefix()}, []string{"a", "b"}},
+		{"a", []clientv3.OpOption{clientv3.WithFromKey()}, []string{"a", "b"}},
+		{"", []clientv3.OpOption{clientv3.WithFromKey()}, []string{"a", "b"}},
+		{"a", []clientv3.OpOption{clientv3.WithRange("b")}, []string{"a"}},
+	}
+	for i, tt := range tests {
+		resp, err := nsKV.Get(context.TODO(), tt.key, tt.opts...)
+		if err != nil {
+			t.Fatalf("#%d: couldn't range (%v)", i, err)
+		}
+		var keys []string
+		for _, kv := range resp.Kvs {
+			keys = append(keys, string(kv.Key))
+		}
+		if !reflect.DeepEqual(keys, tt.wkeys) {
+			t.Errorf("#%d: keys = %v, want %v", i, keys, tt.wkeys)
+		}
+	}
+
+	dresp, err := nsKV.Delete(context.TODO(), "", clientv3.WithPrefix())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dresp.Deleted != 2 {
+		t.Errorf("deleted = %d, want 2", dresp.Deleted)
+	}
+	resp, err := c.Get(context.TODO(), "f", clientv3.WithPrefix())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Kvs) != 3 {
+		t.Errorf("expected 3 keys outside the namespace, got %+v", resp.Kvs)
+	}
+}
+
+func TestNamespaceTxn(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	c := clus.Client(0)
+	if _, err := c.Put(context.TODO(), "abc", "outside"); err != nil {
+		t.Fatal(err)
+	}
+	nsKV := namespace.NewKV(c.KV, "foo/")
+
+	txnResp, err := nsKV.Txn(context.TODO()).
+		If(clientv3.Compare(clientv3.Version("abc"), "=", 0)).
+		Then(clientv3.OpPut("abc", "123"), clientv3.OpGet("abc")).
+		Commit()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !txnResp.Succeeded {
+		t.Fatalf("expected successful txn, got %+v", txnResp)
+	}
+	rresp := txnResp.Responses[1].GetResponseRange()
+	if len(rresp.Kvs) != 1 || string(rresp.Kvs[0].Key) != "abc" {
+		t.Errorf("expected key %q in txn range response, got %+v", "abc", rresp.Kvs)
+	}
+
+	txnResp, err = nsKV.Txn(context.TODO()).
+		If(clientv3.Compare(clientv3.Value("abc"), "=", "123")).
+		Then(clientv3.OpDelete("abc")).
+		Commit()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !txnResp.Succeeded {
+		t.Fatalf("expected successful txn, got %+v", txnResp)
+	}
+
+	resp, err := c.Get(context.TODO(), "abc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Kvs) != 1 || string(resp.Kvs[0].Value) != "outside" {
+		t.Errorf("expected key outside namespace untouched, got %+v", resp.Kvs)
+	}
+}
@@ -41,4 +41,19 @@ func TestRoleError(t *testing.T) {
 	if err != rpctypes.ErrRoleAlreadyExist {
 		t.Fatalf("expected %v, got %v", rpctypes.ErrRoleAlreadyExist, err)
 	}
+
+	_, err = authapi.RoleRevokePermission(context.TODO(), "test-role", "foo", clientv3.GetPrefixRangeEnd("foo"))
+	if err != rpctypes.ErrPermNotGranted {
+		t.Fatalf("expected %v, got %v", rpctypes.ErrPermNotGranted, err)
+	}
+
+	_, err = authapi.RoleDelete(context.TODO(), "test-role")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = authapi.RoleDelete(context.TODO(), "test-role")
+	if err != rpctypes.ErrRoleNotFound {
+		t.Fatalf("expected %v, got %v", rpctypes.ErrRoleNotFound, err)
+	}
 }
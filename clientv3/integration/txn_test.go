@@ -46,6 +46,17 @@ func TestTxnError(t *testing.T) {
 		ops[i] = clientv3.OpPut(fmt.Sprintf("foo%d", i), "")
 	}
 	_, err = kv.Txn(ctx).Then(ops...).Commit()
+	if terr, ok := err.(*clientv3.TxnTooLargeError); !ok || terr.Limit != v3rpc.MaxOpsPerTxn {
+		t.Fatalf("expected *TxnTooLargeError with limit %d, got %v", v3rpc.MaxOpsPerTxn, err)
+	}
+
+	// the server enforces its limit without the client check
+	cli, err := clientv3.New(clientv3.Config{Endpoints: []string{clus.Members[0].GRPCAddr()}, MaxTxnOps: -1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cli.Close()
+	_, err = cli.Txn(ctx).Then(ops...).Commit()
 	if err != rpctypes.ErrTooManyOps {
 		t.Fatalf("expected %v, got %v", rpctypes.ErrTooManyOps, err)
 	}
@@ -164,3 +175,359 @@ func TestTxnSuccess(t *testing.T) {
 		t.Fatalf("unexpected Get response %v", resp)
 	}
 }
+
+// TestTxnNoIf ensures a txn without comparisons succeeds and applies
+// only its Then branch.
+func TestTxnNoIf(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	kv := clientv3.NewKV(clus.Client(0))
+	ctx := context.TODO()
+
+	tresp, err := kv.Txn(ctx).
+		Then(clientv3.OpPut("foo", "then"), clientv3.OpPut("bar", "then")).
+		Else(clientv3.OpPut("foo", "else")).
+		Commit()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !tresp.Succeeded {
+		t.Fatalf("expected txn without comparisons to succeed")
+	}
+	if len(tresp.Responses) != 2 {
+		t.Fatalf("expected 2 responses, got %+v", tresp.Responses)
+	}
+
+	resp, err := kv.Get(ctx, "", clientv3.WithPrefix())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Kvs) != 2 {
+		t.Fatalf("expected 2 keys, got %+v", resp.Kvs)
+	}
+	for _, ev := range resp.Kvs {
+		if string(ev.Value) != "then" {
+			t.Errorf("key %q = %q, want %q", ev.Key, ev.Value, "then")
+		}
+	}
+}
+
+func TestTxnCompareNotEqual(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	kv := clientv3.NewKV(clus.Client(0))
+	ctx := context.TODO()
+
+	if _, err := kv.Put(ctx, "foo", "bar"); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		cmp clientv3.Cmp
+
+		wsuccess bool
+	}{
+		{clientv3.Compare(clientv3.Value("foo"), "!=", "bar"), false},
+		{clientv3.Compare(clientv3.Value("foo"), "!=", "baz"), true},
+		{clientv3.Compare(clientv3.Version("foo"), "!=", 1), false},
+		{clientv3.Compare(clientv3.Version("foo"), "!=", 2), true},
+		{clientv3.Compare(clientv3.CreateRevision("foo"), "!=", 0), true},
+		{clientv3.Compare(clientv3.ModRevision("foo"), "!=", int64(2)), false},
+	}
+	for i, tt := range tests {
+		resp, err := kv.Txn(ctx).If(tt.cmp).Then().Commit()
+		if err != nil {
+			t.Fatalf("#%d: unexpected error %v", i, err)
+		}
+		if resp.Succeeded != tt.wsuccess {
+			t.Errorf("#%d: succeeded = %v, want %v", i, resp.Succeeded, tt.wsuccess)
+		}
+	}
+}
+
+func TestTxnPutDelete(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	kv := clientv3.NewKV(clus.Client(0))
+	ctx := context.TODO()
+
+	for _, k := range []string{"a", "b", "c"} {
+		if _, err := kv.Put(ctx, k, "old"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	resp, err := kv.Txn(ctx).If(
+		clientv3.Compare(clientv3.Value("a"), "=", "old"),
+	).Then(
+		clientv3.OpPut("a", "new"),
+		clientv3.OpDelete("b", clientv3.WithRange("d")),
+		clientv3.OpPut("e", "new"),
+	).Commit()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !resp.Succeeded {
+		t.Fatalf("expected txn to succeed")
+	}
+	if len(resp.Responses) != 3 {
+		t.Fatalf("len(responses) = %d, want 3", len(resp.Responses))
+	}
+	if dresp := resp.Responses[1].GetResponseDeleteRange(); dresp == nil || dresp.Deleted != 2 {
+		t.Fatalf("delete response = %+v, want 2 deleted", dresp)
+	}
+
+	gresp, err := kv.Get(ctx, "a", clientv3.WithFromKey())
+	if err != nil {
+		t.Fatal(err)
+	}
+	wkvs := map[string]string{"a": "new", "e": "new"}
+	if len(gresp.Kvs) != len(wkvs) {
+		t.Fatalf("kvs = %+v, want %v", gresp.Kvs, wkvs)
+	}
+	for _, kv := range gresp.Kvs {
+		if wkvs[string(kv.Key)] != string(kv.Value) {
+			t.Errorf("%s = %q, want %q", kv.Key, kv.Value, wkvs[string(kv.Key)])
+		}
+	}
+}
+
+func TestTxnBatchPutDelete(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	kv := clientv3.NewKV(clus.RandClient())
+	ctx := context.TODO()
+
+	kvs := map[string]string{"a/1": "x", "a/2": "y", "b/1": "z"}
+	tresp, err := clientv3.BatchPut(ctx, kv, kvs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tresp.Responses) != len(kvs) {
+		t.Fatalf("responses = %d, want %d", len(tresp.Responses), len(kvs))
+	}
+	resp, err := kv.Get(ctx, "", clientv3.WithFromKey())
+	if err != nil {
+		t.Fatal(err)
+	}
+	// all keys are written in one revision
+	for _, ev := range resp.Kvs {
+		if string(ev.Value) != kvs[string(ev.Key)] {
+			t.Errorf("%s = %q, want %q", ev.Key, ev.Value, kvs[string(ev.Key)])
+		}
+		if ev.ModRevision != tresp.Header.Revision {
+			t.Errorf("%s mod revision = %d, want %d", ev.Key, ev.ModRevision, tresp.Header.Revision)
+		}
+	}
+	if len(resp.Kvs) != len(kvs) {
+		t.Errorf("got %d keys, want %d", len(resp.Kvs), len(kvs))
+	}
+
+	// a failing batch writes nothing
+	ops := make(map[string]string)
+	for i := 0; i < v3rpc.MaxOpsPerTxn+1; i++ {
+		ops[fmt.Sprintf("c/%d", i)] = ""
+	}
+	if _, err = clientv3.BatchPut(ctx, kv, ops); !isTxnTooLarge(err) {
+		t.Fatalf("err = %v, want *TxnTooLargeError", err)
+	}
+	if resp, err = kv.Get(ctx, "c/", clientv3.WithPrefix(), clientv3.WithCountOnly()); err != nil {
+		t.Fatal(err)
+	}
+	if resp.Count != 0 {
+		t.Fatalf("count = %d, want 0", resp.Count)
+	}
+
+	tresp, err = clientv3.BatchDelete(ctx, kv, []string{"a/", "b/"}, clientv3.WithPrefix())
+	if err != nil {
+		t.Fatal(err)
+	}
+	deleted := int64(0)
+	for _, r := range tresp.Responses {
+		deleted += r.GetResponseDeleteRange().Deleted
+	}
+	if deleted != 3 {
+		t.Errorf("deleted = %d, want 3", deleted)
+	}
+}
+
+func TestTxnCompareAndSwap(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	kv := clientv3.NewKV(clus.RandClient())
+	ctx := context.TODO()
+
+	tests := []struct {
+		f func() (bool, error)
+
+		wok  bool
+		wval string
+	}{
+		{func() (bool, error) { return clientv3.CompareAndSwap(ctx, kv, "foo", "", "bar") }, false, ""},
+		{func() (bool, error) { return clientv3.CompareAndCreate(ctx, kv, "foo", "bar") }, true, "bar"},
+		{func() (bool, error) { return clientv3.CompareAndCreate(ctx, kv, "foo", "baz") }, false, "bar"},
+		{func() (bool, error) { return clientv3.CompareAndSwap(ctx, kv, "foo", "baz", "qux") }, false, "bar"},
+		{func() (bool, error) { return clientv3.CompareAndSwap(ctx, kv, "foo", "bar", "qux") }, true, "qux"},
+	}
+	for i, tt := range tests {
+		ok, err := tt.f()
+		if err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+		if ok != tt.wok {
+			t.Errorf("#%d: succeeded = %v, want %v", i, ok, tt.wok)
+		}
+		resp, err := kv.Get(ctx, "foo")
+		if err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+		val := ""
+		if kv := resp.First(); kv != nil {
+			val = string(kv.Value)
+		}
+		if val != tt.wval {
+			t.Errorf("#%d: value = %q, want %q", i, val, tt.wval)
+		}
+	}
+}
+
+func TestTxnCompareAndDelete(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	kv := clientv3.NewKV(clus.RandClient())
+	ctx := context.TODO()
+
+	if _, err := kv.Put(ctx, "lock", "owner2"); err != nil {
+		t.Fatal(err)
+	}
+	// a stale owner must not release the lock
+	ok, err := clientv3.CompareAndDelete(ctx, kv, "lock", "owner1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok {
+		t.Fatal("expected delete with mismatched value to fail")
+	}
+	resp, err := kv.Get(ctx, "lock")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Kvs) != 1 {
+		t.Fatalf("expected lock to be held, got %+v", resp.Kvs)
+	}
+
+	if ok, err = clientv3.CompareAndDelete(ctx, kv, "lock", "owner2"); err != nil {
+		t.Fatal(err)
+	}
+	if !ok {
+		t.Fatal("expected delete with matching value to succeed")
+	}
+	if resp, err = kv.Get(ctx, "lock"); err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Kvs) != 0 {
+		t.Fatalf("expected lock to be released, got %+v", resp.Kvs)
+	}
+}
+
+func TestTxnGetMany(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	kv := clientv3.NewKV(clus.RandClient())
+	ctx := context.TODO()
+
+	for _, k := range []string{"a", "b", "c"} {
+		if _, err := kv.Put(ctx, k, k+"-val"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	kvs, err := clientv3.GetMany(ctx, kv, []string{"a", "c", "missing", "a"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(kvs) != 2 {
+		t.Fatalf("got %d keys, want 2 (%+v)", len(kvs), kvs)
+	}
+	for _, k := range []string{"a", "c"} {
+		if ev, ok := kvs[k]; !ok || string(ev.Value) != k+"-val" {
+			t.Errorf("%s = %+v, want %q", k, ev, k+"-val")
+		}
+	}
+	if _, ok := kvs["missing"]; ok {
+		t.Errorf("expected missing key to be absent")
+	}
+
+	keys := make([]string, v3rpc.MaxOpsPerTxn+1)
+	for i := range keys {
+		keys[i] = fmt.Sprintf("k/%d", i)
+	}
+	if _, err = clientv3.GetMany(ctx, kv, keys); !isTxnTooLarge(err) {
+		t.Fatalf("err = %v, want *TxnTooLargeError", err)
+	}
+}
+
+func isTxnTooLarge(err error) bool {
+	_, ok := err.(*clientv3.TxnTooLargeError)
+	return ok
+}
+
+func TestTxnPutIfChanged(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	kv := clientv3.NewKV(clus.RandClient())
+	ctx := context.TODO()
+
+	tests := []struct {
+		val string
+
+		wok   bool
+		wmods int64
+	}{
+		// a missing key is put
+		{"bar", true, 1},
+		{"bar", false, 1},
+		{"baz", true, 2},
+		{"baz", false, 2},
+	}
+	for i, tt := range tests {
+		ok, err := clientv3.PutIfChanged(ctx, kv, "foo", tt.val)
+		if err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+		if ok != tt.wok {
+			t.Errorf("#%d: put = %v, want %v", i, ok, tt.wok)
+		}
+		resp, err := kv.Get(ctx, "foo")
+		if err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+		if v := resp.Kvs[0].Version; v != tt.wmods {
+			t.Errorf("#%d: version = %d, want %d", i, v, tt.wmods)
+		}
+	}
+}
@@ -16,8 +16,12 @@ package integration
 
 import (
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
 	"sort"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -27,6 +31,7 @@ import (
 	"github.com/coreos/etcd/integration"
 	mvccpb "github.com/coreos/etcd/mvcc/mvccpb"
 	"github.com/coreos/etcd/pkg/testutil"
+	"github.com/prometheus/client_golang/prometheus"
 	"golang.org/x/net/context"
 )
 
@@ -173,6 +178,37 @@ func testWatchRange(t *testing.T, wctx *watchctx) {
 	putAndWatch(t, wctx, "bar", "bar")
 }
 
+// TestWatchPrefix tests watcher on key prefixes
+func TestWatchPrefix(t *testing.T) {
+	runWatchTest(t, testWatchPrefix)
+}
+
+func testWatchPrefix(t *testing.T, wctx *watchctx) {
+	if wctx.ch = wctx.w.Watch(context.TODO(), "a", clientv3.WithPrefix()); wctx.ch == nil {
+		t.Fatalf("expected non-nil channel")
+	}
+	putAndWatch(t, wctx, "a", "a")
+	putAndWatch(t, wctx, "abc", "abc")
+	if _, err := wctx.kv.Put(context.TODO(), "b", "b"); err != nil {
+		t.Fatal(err)
+	}
+	putAndWatch(t, wctx, "ab", "ab")
+}
+
+// TestWatchPrefixAll tests a watcher on the empty prefix receives all keys
+func TestWatchPrefixAll(t *testing.T) {
+	runWatchTest(t, testWatchPrefixAll)
+}
+
+func testWatchPrefixAll(t *testing.T, wctx *watchctx) {
+	if wctx.ch = wctx.w.Watch(context.TODO(), "", clientv3.WithPrefix()); wctx.ch == nil {
+		t.Fatalf("expected non-nil channel")
+	}
+	putAndWatch(t, wctx, "a", "a")
+	putAndWatch(t, wctx, "zzz", "zzz")
+	putAndWatch(t, wctx, "\xff", "ff")
+}
+
 // TestWatchReconnRequest tests the send failure path when requesting a watcher.
 func TestWatchReconnRequest(t *testing.T) {
 	runWatchTest(t, testWatchReconnRequest)
@@ -242,8 +278,52 @@ func testWatchReconnRunning(t *testing.T, wctx *watchctx) {
 	putAndWatch(t, wctx, "a", "b")
 }
 
+// TestWatchReconnNoDuplicates tests watcher resumes from the revision
+// after the last received event, neither repeating nor dropping events.
+func TestWatchReconnNoDuplicates(t *testing.T) {
+	runWatchTest(t, testWatchReconnNoDuplicates)
+}
+
+func testWatchReconnNoDuplicates(t *testing.T, wctx *watchctx) {
+	if wctx.ch = wctx.w.Watch(context.TODO(), "a", clientv3.WithPrefix()); wctx.ch == nil {
+		t.Fatalf("expected non-nil channel")
+	}
+	putAndWatch(t, wctx, "a", "a1")
+	putAndWatch(t, wctx, "ab", "a2")
+	// take down watcher connection
+	wctx.wclient.ActiveConnection().Close()
+	for _, v := range []string{"a3", "a4", "a5"} {
+		if _, err := wctx.kv.Put(context.TODO(), "a", v); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var vals []string
+	for len(vals) < 3 {
+		select {
+		case <-time.After(5 * time.Second):
+			t.Fatalf("watch timed out, got %v", vals)
+		case wresp, ok := <-wctx.ch:
+			if !ok {
+				t.Fatalf("unexpected watch close")
+			}
+			for _, ev := range wresp.Events {
+				vals = append(vals, string(ev.Kv.Value))
+			}
+		}
+	}
+	if wvals := []string{"a3", "a4", "a5"}; !reflect.DeepEqual(vals, wvals) {
+		t.Fatalf("values = %v, want %v", vals, wvals)
+	}
+	select {
+	case wresp := <-wctx.ch:
+		t.Fatalf("unexpected watch response %+v", wresp)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
 // TestWatchCancelImmediate ensures a closed channel is returned
-// if the context is cancelled.
+// if the context is cancelled, after a response giving the reason.
 func TestWatchCancelImmediate(t *testing.T) {
 	runWatchTest(t, testWatchCancelImmediate)
 }
@@ -253,6 +333,14 @@ func testWatchCancelImmediate(t *testing.T, wctx *watchctx) {
 	cancel()
 	wch := wctx.w.Watch(ctx, "a")
 	select {
+	case wresp, ok := <-wch:
+		if !ok || !wresp.Canceled || wresp.Err() != context.Canceled {
+			t.Fatalf("read wch got %+v (%v); expected canceled response", wresp, wresp.Err())
+		}
+	default:
+		t.Fatalf("closed watcher channel should not block")
+	}
+	select {
 	case wresp, ok := <-wch:
 		if ok {
 			t.Fatalf("read wch got %v; expected closed channel", wresp)
@@ -273,12 +361,29 @@ func testWatchCancelInit(t *testing.T, wctx *watchctx) {
 		t.Fatalf("expected non-nil watcher channel")
 	}
 	cancel()
+	expectWatchClose(t, wctx.ch, context.Canceled)
+}
+
+// expectWatchClose checks the watch channel sends a final response with
+// the given reason, then closes.
+func expectWatchClose(t *testing.T, wch clientv3.WatchChan, werr error) {
 	select {
 	case <-time.After(time.Second):
 		t.Fatalf("took too long to cancel")
-	case _, ok := <-wctx.ch:
+	case wresp, ok := <-wch:
+		if !ok {
+			t.Fatalf("expected final watch response")
+		}
+		if !wresp.Canceled || wresp.Err() != werr {
+			t.Fatalf("final response %+v has error %v, want %v", wresp, wresp.Err(), werr)
+		}
+	}
+	select {
+	case <-time.After(time.Second):
+		t.Fatalf("took too long to close")
+	case wresp, ok := <-wch:
 		if ok {
-			t.Fatalf("expected watcher channel to close")
+			t.Fatalf("expected watcher channel to close, got %v", wresp)
 		}
 	}
 }
@@ -302,19 +407,108 @@ func testWatchCancelRunning(t *testing.T, wctx *watchctx) {
 		t.Fatalf("took too long to cancel")
 	case v, ok := <-wctx.ch:
 		if !ok {
-			// closed before getting put; OK
+			t.Fatalf("expected final watch response")
+		}
+		if v.Canceled {
+			// canceled before getting put; OK
+			if v.Err() != context.Canceled {
+				t.Fatalf("final response error %v, want %v", v.Err(), context.Canceled)
+			}
+			if _, ok = <-wctx.ch; ok {
+				t.Fatalf("expected watcher channel to close")
+			}
 			break
 		}
 		// got the PUT; should close next
-		select {
-		case <-time.After(time.Second):
-			t.Fatalf("took too long to close")
-		case v, ok = <-wctx.ch:
-			if ok {
-				t.Fatalf("expected watcher channel to close, got %v", v)
+		expectWatchClose(t, wctx.ch, context.Canceled)
+	}
+}
+
+// serverWatchers returns the number of watchers in the stores of the
+// cluster members, which run in this process.
+func serverWatchers(t *testing.T) int {
+	req, err := http.NewRequest("GET", "/metrics", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rec := httptest.NewRecorder()
+	prometheus.Handler().ServeHTTP(rec, req)
+	for _, l := range strings.Split(rec.Body.String(), "\n") {
+		if strings.HasPrefix(l, "etcd_debugging_mvcc_watcher_total ") {
+			n, err := strconv.ParseFloat(strings.TrimPrefix(l, "etcd_debugging_mvcc_watcher_total "), 64)
+			if err != nil {
+				t.Fatal(err)
 			}
+			return int(n)
 		}
 	}
+	t.Fatalf("watcher metric not found")
+	return 0
+}
+
+// TestWatchCancelShared ensures canceling one of the watches sharing a
+// stream cancels it on the server while the others keep receiving events.
+func TestWatchCancelShared(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	w := clientv3.NewWatcher(clus.Client(0))
+	defer w.Close()
+
+	base := serverWatchers(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	wcha := w.Watch(ctx, "a")
+	wchb := w.Watch(context.Background(), "b")
+	if n := serverWatchers(t); n != base+2 {
+		t.Fatalf("watchers = %d, want %d", n, base+2)
+	}
+
+	cancel()
+	for range wcha {
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for serverWatchers(t) != base+1 {
+		if time.Now().After(deadline) {
+			t.Fatalf("watchers = %d, want %d", serverWatchers(t), base+1)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	if _, err := clus.Client(0).Put(context.TODO(), "b", "1"); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case wresp := <-wchb:
+		if len(wresp.Events) != 1 || string(wresp.Events[0].Kv.Key) != "b" {
+			t.Fatalf("unexpected response %+v", wresp)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("timed out waiting for event on shared stream")
+	}
+}
+
+// TestWatchCloseReason ensures watches ended by their context deadline or
+// by closing the watcher say so in their final response.
+func TestWatchCloseReason(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	w := clientv3.NewWatcher(clus.Client(0))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	expectWatchClose(t, w.Watch(ctx, "a"), context.DeadlineExceeded)
+
+	wch := w.Watch(context.Background(), "a")
+	w.Close()
+	expectWatchClose(t, wch, clientv3.ErrWatcherClosed)
+
+	// watching on a closed watcher also gives the reason
+	expectWatchClose(t, w.Watch(context.Background(), "a"), clientv3.ErrWatcherClosed)
 }
 
 func putAndWatch(t *testing.T, wctx *watchctx, key, val string) {
@@ -429,6 +623,66 @@ func TestWatchCompactRevision(t *testing.T) {
 	}
 }
 
+// TestWatchSnapshotRecovery ensures a watch with WithSnapshotRecovery
+// resumes from a snapshot of its keys when its revision is compacted.
+func TestWatchSnapshotRecovery(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 3})
+	defer clus.Terminate(t)
+
+	kv := clientv3.NewKV(clus.RandClient())
+	for _, k := range []string{"foo/a", "foo/b", "foo/a", "foo/c"} {
+		if _, err := kv.Put(context.TODO(), k, "bar"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := kv.Delete(context.TODO(), "foo/c"); err != nil {
+		t.Fatal(err)
+	}
+	if err := kv.Compact(context.TODO(), 4); err != nil {
+		t.Fatal(err)
+	}
+
+	w := clientv3.NewWatcher(clus.RandClient())
+	defer w.Close()
+	wch := w.Watch(context.Background(), "foo/", clientv3.WithPrefix(), clientv3.WithRev(2), clientv3.WithSnapshotRecovery())
+
+	wresp, ok := <-wch
+	if !ok {
+		t.Fatalf("expected wresp, but got closed channel")
+	}
+	if !wresp.Snapshot || wresp.Err() != nil {
+		t.Fatalf("expected snapshot, got %+v", wresp)
+	}
+	if wresp.Header.Revision != 6 {
+		t.Fatalf("snapshot revision = %d, want 6", wresp.Header.Revision)
+	}
+	var keys []string
+	for _, ev := range wresp.Events {
+		if ev.Type != clientv3.EventTypePut {
+			t.Fatalf("unexpected snapshot event type %v", ev.Type)
+		}
+		keys = append(keys, string(ev.Kv.Key))
+	}
+	if wkeys := []string{"foo/a", "foo/b"}; !reflect.DeepEqual(keys, wkeys) {
+		t.Fatalf("snapshot keys = %v, want %v", keys, wkeys)
+	}
+
+	// the watch resumes after the snapshot
+	if _, err := kv.Put(context.TODO(), "foo/d", "bar"); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case wresp = <-wch:
+		if wresp.Snapshot || len(wresp.Events) != 1 || wresp.Events[0].Kv.ModRevision != 7 {
+			t.Fatalf("expected event at revision 7, got %+v", wresp)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("timed out waiting for event")
+	}
+}
+
 func TestWatchWithProgressNotify(t *testing.T)        { testWatchWithProgressNotify(t, true) }
 func TestWatchWithProgressNotifyNoEvent(t *testing.T) { testWatchWithProgressNotify(t, false) }
 
@@ -459,6 +713,9 @@ func testWatchWithProgressNotify(t *testing.T, watchOnPut bool) {
 		if len(resp.Events) != 0 {
 			t.Fatalf("resp.Events expected none, got %+v", resp.Events)
 		}
+		if !resp.IsProgressNotify() {
+			t.Fatalf("expected progress notification, got %+v", resp)
+		}
 	case <-time.After(2 * pi):
 		t.Fatalf("watch response expected in %v, but timed out", pi)
 	}
@@ -487,6 +744,93 @@ func testWatchWithProgressNotify(t *testing.T, watchOnPut bool) {
 	}
 }
 
+// TestWatchWithLeaderRequired ensures watches requiring a leader are closed
+// with ErrNoLeader once their member loses its leader, while other watches
+// of the watcher carry on.
+func TestWatchWithLeaderRequired(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 3})
+	defer clus.Terminate(t)
+
+	w := clientv3.NewWatcher(clus.Client(0))
+	defer w.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	lwch := w.Watch(ctx, "foo", clientv3.WithLeaderRequired())
+	wch := w.Watch(ctx, "foo")
+	// ensure both watches are registered
+	if _, err := clus.Client(0).Put(context.TODO(), "foo", "bar"); err != nil {
+		t.Fatal(err)
+	}
+	for _, ch := range []clientv3.WatchChan{lwch, wch} {
+		select {
+		case <-ch:
+		case <-time.After(5 * time.Second):
+			t.Fatal("took too long to receive event")
+		}
+	}
+
+	clus.Members[1].Stop(t)
+	clus.Members[2].Stop(t)
+
+	select {
+	case resp, ok := <-lwch:
+		if !ok {
+			t.Fatal("expected a final response with the error")
+		}
+		if resp.Err() != rpctypes.ErrNoLeader {
+			t.Fatalf("err = %v, want %v", resp.Err(), rpctypes.ErrNoLeader)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("watch requiring a leader was not closed")
+	}
+	select {
+	case resp, ok := <-wch:
+		t.Fatalf("unexpected response %+v (open %v) on watch not requiring a leader", resp, ok)
+	default:
+	}
+}
+
+func TestWatchRequestProgress(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	wc := clientv3.NewWatcher(clus.RandClient())
+	defer wc.Close()
+
+	// both watches share the stream, so both get a progress response
+	rchs := []clientv3.WatchChan{
+		wc.Watch(context.Background(), "foo"),
+		wc.Watch(context.Background(), "baz"),
+	}
+
+	kvc := clientv3.NewKV(clus.RandClient())
+	if _, err := kvc.Put(context.TODO(), "bar", "1"); err != nil {
+		t.Fatal(err)
+	}
+	if err := wc.RequestProgress(context.TODO()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, rch := range rchs {
+		select {
+		case resp := <-rch:
+			if !resp.IsProgressNotify() {
+				t.Fatalf("#%d: expected progress notification, got %+v", i, resp)
+			}
+			if resp.Header.Revision != 2 {
+				t.Fatalf("#%d: resp.Header.Revision expected 2, got %d", i, resp.Header.Revision)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("#%d: progress response expected, but timed out", i)
+		}
+	}
+}
+
 func TestWatchEventType(t *testing.T) {
 	cluster := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 1})
 	defer cluster.Terminate(t)
@@ -558,3 +902,111 @@ func TestWatchEventType(t *testing.T) {
 		}
 	}
 }
+
+func TestWatchFilter(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	cluster := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 1})
+	defer cluster.Terminate(t)
+
+	client := cluster.RandClient()
+	ctx := context.Background()
+
+	tests := []struct {
+		opt clientv3.OpOption
+
+		wtype mvccpb.Event_EventType
+	}{
+		{clientv3.WithFilterPut(), clientv3.EventTypeDelete},
+		{clientv3.WithFilterDelete(), clientv3.EventTypePut},
+	}
+	for i, tt := range tests {
+		key := fmt.Sprintf("filter%d", i)
+		wch := client.Watch(ctx, key, tt.opt)
+		for j := 0; j < 2; j++ {
+			if _, err := client.Put(ctx, key, "bar"); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := client.Delete(ctx, key); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		// puts and deletes alternate, so any unfiltered event shows up
+		// among the first two received
+		var evs []*clientv3.Event
+		for len(evs) < 2 {
+			select {
+			case wresp := <-wch:
+				evs = append(evs, wresp.Events...)
+			case <-time.After(5 * time.Second):
+				t.Fatalf("#%d: timed out waiting for events", i)
+			}
+		}
+		for j, ev := range evs {
+			if ev.Type != tt.wtype {
+				t.Errorf("#%d.%d: event type = %v, want %v", i, j, ev.Type, tt.wtype)
+			}
+		}
+	}
+}
+
+func TestWatchWithPrevKV(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	cli := clus.RandClient()
+	presp, err := cli.Put(context.TODO(), "foo/a", "v1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = cli.Put(context.TODO(), "foo/a", "v2"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = cli.Delete(context.TODO(), "foo/a"); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		opts []clientv3.OpOption
+
+		wtypes []mvccpb.Event_EventType
+		wprevs []string
+	}{
+		{
+			[]clientv3.OpOption{clientv3.WithPrefix(), clientv3.WithPrevKV()},
+			[]mvccpb.Event_EventType{clientv3.EventTypePut, clientv3.EventTypeDelete},
+			[]string{"v1", "v2"},
+		},
+		{
+			[]clientv3.OpOption{clientv3.WithPrefix(), clientv3.WithPrevKV(), clientv3.WithFilterPut()},
+			[]mvccpb.Event_EventType{clientv3.EventTypeDelete},
+			[]string{"v2"},
+		},
+	}
+	for i, tt := range tests {
+		// replay the events after the first put
+		opts := append(tt.opts, clientv3.WithRev(presp.Header.Revision+1))
+		wch := cli.Watch(context.TODO(), "foo/", opts...)
+
+		var evs []*clientv3.Event
+		for len(evs) < len(tt.wtypes) {
+			select {
+			case wresp := <-wch:
+				evs = append(evs, wresp.Events...)
+			case <-time.After(5 * time.Second):
+				t.Fatalf("#%d: timed out waiting for events", i)
+			}
+		}
+		for j, ev := range evs {
+			if ev.Type != tt.wtypes[j] {
+				t.Errorf("#%d.%d: event type = %v, want %v", i, j, ev.Type, tt.wtypes[j])
+			}
+			if ev.PrevKv == nil || string(ev.PrevKv.Value) != tt.wprevs[j] {
+				t.Errorf("#%d.%d: prev kv = %+v, want value %q", i, j, ev.PrevKv, tt.wprevs[j])
+			}
+		}
+	}
+}
@@ -15,12 +15,23 @@
 package clientv3
 
 import (
+	"encoding/json"
+	"errors"
+	"sort"
+	"sync/atomic"
+	"time"
+
 	"github.com/coreos/etcd/etcdserver/api/v3rpc/rpctypes"
 	pb "github.com/coreos/etcd/etcdserver/etcdserverpb"
+	"github.com/coreos/etcd/mvcc/mvccpb"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 )
 
+// ErrEmptyPrefix is returned by DeleteAll when given an empty prefix,
+// which would otherwise delete every key.
+var ErrEmptyPrefix = errors.New("etcdclient: empty prefix")
+
 type (
 	PutResponse    pb.PutResponse
 	GetResponse    pb.RangeResponse
@@ -28,6 +39,86 @@ type (
 	TxnResponse    pb.TxnResponse
 )
 
+// The responses keep the Header field of their protobuf types, so their
+// header accessors are named after the generated getters. They are safe to
+// call on a nil response.
+
+// GetHeader returns the header of the put response.
+func (resp *PutResponse) GetHeader() *pb.ResponseHeader { return (*pb.PutResponse)(resp).GetHeader() }
+
+// GetHeader returns the header of the get response.
+func (resp *GetResponse) GetHeader() *pb.ResponseHeader { return (*pb.RangeResponse)(resp).GetHeader() }
+
+// GetHeader returns the header of the delete response.
+func (resp *DeleteResponse) GetHeader() *pb.ResponseHeader {
+	return (*pb.DeleteRangeResponse)(resp).GetHeader()
+}
+
+// GetHeader returns the header of the txn response.
+func (resp *TxnResponse) GetHeader() *pb.ResponseHeader { return (*pb.TxnResponse)(resp).GetHeader() }
+
+// The op results of a txn are indexed by the position of their op in the
+// branch that was applied, and share the header of the txn. The accessors
+// return nil if i is out of range or the op at i is of another type.
+
+// GetRange returns the result of the get op at index i of the txn.
+func (resp *TxnResponse) GetRange(i int) *GetResponse {
+	if r, ok := resp.response(i).(*pb.ResponseUnion_ResponseRange); ok && r.ResponseRange != nil {
+		return (*GetResponse)(r.ResponseRange)
+	}
+	return nil
+}
+
+// GetPut returns the result of the put op at index i of the txn.
+func (resp *TxnResponse) GetPut(i int) *PutResponse {
+	if r, ok := resp.response(i).(*pb.ResponseUnion_ResponsePut); ok && r.ResponsePut != nil {
+		return (*PutResponse)(r.ResponsePut)
+	}
+	return nil
+}
+
+// GetDelete returns the result of the delete op at index i of the txn.
+func (resp *TxnResponse) GetDelete(i int) *DeleteResponse {
+	if r, ok := resp.response(i).(*pb.ResponseUnion_ResponseDeleteRange); ok && r.ResponseDeleteRange != nil {
+		return (*DeleteResponse)(r.ResponseDeleteRange)
+	}
+	return nil
+}
+
+func (resp *TxnResponse) response(i int) interface{} {
+	if resp == nil || i < 0 || i >= len(resp.Responses) || resp.Responses[i] == nil {
+		return nil
+	}
+	return resp.Responses[i].Response
+}
+
+// Keys returns the keys of the response's key-value pairs, in order.
+func (resp *GetResponse) Keys() []string {
+	keys := make([]string, len(resp.Kvs))
+	for i, kv := range resp.Kvs {
+		keys[i] = string(kv.Key)
+	}
+	return keys
+}
+
+// Values returns the values of the response's key-value pairs, in order.
+func (resp *GetResponse) Values() []string {
+	vals := make([]string, len(resp.Kvs))
+	for i, kv := range resp.Kvs {
+		vals[i] = string(kv.Value)
+	}
+	return vals
+}
+
+// First returns the first key-value pair of the response, or nil if the
+// response holds no keys.
+func (resp *GetResponse) First() *mvccpb.KeyValue {
+	if len(resp.Kvs) == 0 {
+		return nil
+	}
+	return resp.Kvs[0]
+}
+
 type KV interface {
 	// Put puts a key-value pair into etcd.
 	// Note that key,value can be plain bytes array and string is
@@ -40,21 +131,31 @@ type KV interface {
 	// When passed WithRange(end), Get will return the keys in the range [key, end).
 	// When passed WithFromKey(), Get returns keys greater than or equal to key.
 	// When passed WithRev(rev) with rev > 0, Get retrieves keys at the given revision;
-	// if the required revision is compacted, the request will fail with ErrCompacted .
-	// When passed WithLimit(limit), the number of returned keys is bounded by limit.
+	// if the required revision is compacted, the request will fail with
+	// rpctypes.ErrCompacted, and with rpctypes.ErrFutureRev if it is not yet reached.
+	// When passed WithLimit(limit), the number of returned keys is bounded by limit;
+	// More in the response reports whether there are keys beyond the limit.
 	// When passed WithSort(), the keys will be sorted.
+	// When passed WithCountOnly(), only the number of keys is returned.
+	// When passed WithKeysOnly(), only the keys are returned, without values.
+	// When passed WithMinCreateRev(rev) or WithMaxCreateRev(rev), only keys created
+	// within the inclusive revision bounds are returned. Likewise, WithMinModRev(rev) and
+	// WithMaxModRev(rev) bound the revisions at which the returned keys were last modified.
+	// When passed WithSerializable(), Get is served by the local member without
+	// a quorum round-trip and may return stale data.
 	Get(ctx context.Context, key string, opts ...OpOption) (*GetResponse, error)
 
 	// Delete deletes a key, or optionally using WithRange(end), [key, end).
 	Delete(ctx context.Context, key string, opts ...OpOption) (*DeleteResponse, error)
 
 	// Compact compacts etcd KV history before the given rev.
-	Compact(ctx context.Context, rev int64) error
-
-	// Do applies a single Op on KV without a transaction.
-	// Do is useful when declaring operations to be issued at a later time
-	// whereas Get/Put/Delete are for better suited for when the operation
-	// should be immediately issued at time of declaration.
+	// When passed WithCompactPhysical(), Compact returns only after the
+	// compacted entries are removed from the backend database.
+	// Compact succeeds if the history is already compacted at or past rev,
+	// so a periodic compaction does not fail on a revision compacted by an
+	// earlier run or another client. Since compacting is idempotent, failed
+	// attempts are retried like reads.
+	Compact(ctx context.Context, rev int64, opts ...CompactOption) error
 
 	// Do applies a single Op on KV without a transaction.
 	// Do is useful when creating arbitrary operations to be issued at a
@@ -67,16 +168,36 @@ type KV interface {
 	Txn(ctx context.Context) Txn
 }
 
+// OpResponse holds the response of an Op applied with Do. Only the
+// accessor that matches the type of the Op returns a non-nil response.
 type OpResponse struct {
 	put *PutResponse
 	get *GetResponse
 	del *DeleteResponse
 }
 
-func (op OpResponse) Put() *PutResponse    { return op.put }
-func (op OpResponse) Get() *GetResponse    { return op.get }
+// Put returns the response of a put Op.
+func (op OpResponse) Put() *PutResponse { return op.put }
+
+// Get returns the response of a range Op.
+func (op OpResponse) Get() *GetResponse { return op.get }
+
+// Del returns the response of a delete Op.
 func (op OpResponse) Del() *DeleteResponse { return op.del }
 
+// Header returns the header of the response, whichever the operation.
+func (op OpResponse) Header() *pb.ResponseHeader {
+	switch {
+	case op.put != nil:
+		return op.put.GetHeader()
+	case op.get != nil:
+		return op.get.GetHeader()
+	case op.del != nil:
+		return op.del.GetHeader()
+	}
+	return nil
+}
+
 type kv struct {
 	rc     *remoteClient
 	remote pb.KVClient
@@ -84,11 +205,249 @@ type kv struct {
 
 func NewKV(c *Client) KV {
 	ret := &kv{}
-	f := func(conn *grpc.ClientConn) { ret.remote = pb.NewKVClient(conn) }
+	f := func(conn *grpc.ClientConn) { ret.remote = c.retryKVClient(c.metricsKVClient(pb.NewKVClient(conn))) }
 	ret.rc = newRemoteClient(c, f)
 	return ret
 }
 
+// NewKVFromKVClient returns a KV that issues its requests to remote instead
+// of over a client connection, e.g., to test code using KV against a fake
+// remote. Requests are retried on remote as the client given cfg would
+// retry them; its Endpoints and connection settings are ignored.
+func NewKVFromKVClient(remote pb.KVClient, cfg Config) KV {
+	c := newDetachedClient(cfg)
+	return &kv{rc: newFixedRemoteClient(c), remote: c.retryKVClient(c.metricsKVClient(remote))}
+}
+
+// GetPrefix retrieves the keys with the given prefix from kv. It is
+// equivalent to calling Get with WithPrefix() ahead of opts, so options
+// such as WithLimit or WithSort still apply.
+func GetPrefix(ctx context.Context, kv KV, prefix string, opts ...OpOption) (*GetResponse, error) {
+	return kv.Get(ctx, prefix, append([]OpOption{WithPrefix()}, opts...)...)
+}
+
+// GetOldest retrieves the n keys with the given prefix that were created
+// first, ordered by ascending create revision so a queue consumer can
+// process them in FIFO order. A non-positive n retrieves all keys. Options
+// such as WithKeysOnly or WithRev still apply.
+func GetOldest(ctx context.Context, kv KV, prefix string, n int64, opts ...OpOption) (*GetResponse, error) {
+	if n < 0 {
+		n = 0
+	}
+	opts = append(opts, WithSortByCreateRevision(SortAscend), WithLimit(n))
+	return GetPrefix(ctx, kv, prefix, opts...)
+}
+
+// defaultScanPageSize is the number of keys fetched per range by Scan when
+// no page size is given.
+const defaultScanPageSize = 1000
+
+// Scan calls f on each key with the given prefix in key order, fetching at
+// most pageSize keys per range request; a non-positive pageSize uses a
+// default. All pages are read at the revision of the first page, or at the
+// revision given by WithRev, so the scan sees a consistent snapshot under
+// concurrent writes; it fails with ErrCompacted if that revision is
+// compacted before the scan completes. Options such as WithKeysOnly or
+// WithSerializable apply to every page, while the range, limit and sort
+// order are set by Scan. Scanning stops at the first error returned by f,
+// which is returned to the caller.
+func Scan(ctx context.Context, kv KV, prefix string, pageSize int64, f func(*mvccpb.KeyValue) error, opts ...OpOption) error {
+	if pageSize <= 0 {
+		pageSize = defaultScanPageSize
+	}
+	op := OpGet(prefix, opts...)
+	op.key = []byte(prefix)
+	WithPrefix()(&op)
+	op.resolveEmptyKey()
+	op.limit, op.sort = pageSize, nil
+	for {
+		resp, err := kv.Do(ctx, op)
+		if err != nil {
+			return err
+		}
+		get := resp.Get()
+		for _, ev := range get.Kvs {
+			if err := f(ev); err != nil {
+				return err
+			}
+		}
+		if !get.More || len(get.Kvs) == 0 {
+			return nil
+		}
+		if op.rev == 0 {
+			op.rev = get.Header.Revision
+		}
+		last := get.Kvs[len(get.Kvs)-1].Key
+		op.key = append(append([]byte{}, last...), 0)
+	}
+}
+
+// DeleteAll deletes the keys with the given prefix from kv and returns
+// the number of deleted keys. An empty prefix is refused with
+// ErrEmptyPrefix rather than deleting the entire keyspace.
+func DeleteAll(ctx context.Context, kv KV, prefix string) (int64, error) {
+	if len(prefix) == 0 {
+		return 0, ErrEmptyPrefix
+	}
+	r, err := kv.Do(ctx, OpDelete(prefix, WithPrefix()))
+	if err != nil {
+		return 0, rpctypes.Error(err)
+	}
+	return r.Del().Deleted, nil
+}
+
+// CompactTo compacts the history of kv before the current revision minus
+// keepRevisions, so the keepRevisions revisions preceding the current one
+// stay readable. The current revision is read from the header of a
+// count-only range. It does nothing if there are not enough revisions yet,
+// or if the history is already compacted past that revision, so it can run
+// periodically.
+func CompactTo(ctx context.Context, kv KV, keepRevisions int64, opts ...CompactOption) error {
+	resp, err := kv.Get(ctx, "\x00", WithCountOnly())
+	if err != nil {
+		return err
+	}
+	rev := resp.Header.Revision - keepRevisions
+	if rev <= 0 {
+		return nil
+	}
+	if err := kv.Compact(ctx, rev, opts...); err != nil && err != rpctypes.ErrCompacted {
+		return err
+	}
+	return nil
+}
+
+// GetMany fetches the given keys in a single transaction, so all values are
+// read at the same revision in one round trip. Keys that do not exist are
+// absent from the returned map. As with BatchPut, the number of keys is
+// bounded by the server's limit on operations per transaction.
+func GetMany(ctx context.Context, kv KV, keys []string) (map[string]*mvccpb.KeyValue, error) {
+	ops := make([]Op, 0, len(keys))
+	seen := make(map[string]struct{}, len(keys))
+	for _, k := range keys {
+		if _, ok := seen[k]; ok {
+			continue
+		}
+		seen[k] = struct{}{}
+		ops = append(ops, OpGet(k))
+	}
+	resp, err := kv.Txn(ctx).Then(ops...).Commit()
+	if err != nil {
+		return nil, err
+	}
+	kvs := make(map[string]*mvccpb.KeyValue, len(ops))
+	for _, r := range resp.Responses {
+		for _, ev := range r.GetResponseRange().Kvs {
+			kvs[string(ev.Key)] = ev
+		}
+	}
+	return kvs, nil
+}
+
+// BatchPut atomically puts all given key-value pairs in a single
+// transaction, applying opts to each put. Either all keys are written or
+// none are; the number of keys is bounded by the server's limit on
+// operations per transaction.
+func BatchPut(ctx context.Context, kv KV, kvs map[string]string, opts ...OpOption) (*TxnResponse, error) {
+	keys := make([]string, 0, len(kvs))
+	for k := range kvs {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	ops := make([]Op, len(keys))
+	for i, k := range keys {
+		ops[i] = OpPut(k, kvs[k], opts...)
+	}
+	return kv.Txn(ctx).Then(ops...).Commit()
+}
+
+// BatchDelete atomically deletes all given keys in a single transaction,
+// applying opts to each delete (e.g., WithPrefix to delete prefixes).
+func BatchDelete(ctx context.Context, kv KV, keys []string, opts ...OpOption) (*TxnResponse, error) {
+	ops := make([]Op, len(keys))
+	for i, k := range keys {
+		ops[i] = OpDelete(k, opts...)
+	}
+	return kv.Txn(ctx).Then(ops...).Commit()
+}
+
+// GetJSON gets a single key from kv and decodes its value as JSON into v.
+// It fails with ErrKeyNotFound if the key does not exist.
+func GetJSON(ctx context.Context, kv KV, key string, v interface{}) error {
+	resp, err := kv.Get(ctx, key)
+	if err != nil {
+		return err
+	}
+	if len(resp.Kvs) == 0 {
+		return rpctypes.ErrKeyNotFound
+	}
+	return json.Unmarshal(resp.Kvs[0].Value, v)
+}
+
+// PutJSON encodes v as JSON and puts it as the value of key.
+func PutJSON(ctx context.Context, kv KV, key string, v interface{}, opts ...OpOption) (*PutResponse, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return kv.Put(ctx, key, string(b), opts...)
+}
+
+// PutRev puts key and returns the revision at which the write was applied,
+// which is the key's new ModRevision.
+func PutRev(ctx context.Context, kv KV, key, val string, opts ...OpOption) (int64, error) {
+	resp, err := kv.Put(ctx, key, val, opts...)
+	if err != nil {
+		return 0, err
+	}
+	return resp.Header.Revision, nil
+}
+
+// Exists returns true if key exists. It issues a Get with WithCountOnly, so
+// the value is not transferred; opts still apply (e.g., WithSerializable).
+func Exists(ctx context.Context, kv KV, key string, opts ...OpOption) (bool, error) {
+	resp, err := kv.Get(ctx, key, append(opts, WithCountOnly())...)
+	if err != nil {
+		return false, err
+	}
+	return resp.Count > 0, nil
+}
+
+// DoOncePrefix is the prefix of the marker keys written by DoOnce.
+const DoOncePrefix = "_doonce/"
+
+// DoOnce applies op at most once for the given requestID, so a write may be
+// retried after a failure that leaves unknown whether it was applied. The op
+// is applied in a transaction that also creates the marker key DoOncePrefix +
+// requestID, provided the marker does not exist yet; opts apply to the marker
+// put (e.g., WithLease, to expire markers of old requests). If the marker
+// exists, op was applied by an earlier call and DoOnce returns applied false
+// with an empty OpResponse.
+func DoOnce(ctx context.Context, kv KV, requestID string, op Op, opts ...OpOption) (resp OpResponse, applied bool, err error) {
+	marker := DoOncePrefix + requestID
+	tresp, err := kv.Txn(ctx).
+		If(Compare(CreateRevision(marker), "=", 0)).
+		Then(op, OpPut(marker, "", opts...)).
+		Commit()
+	if err != nil {
+		return OpResponse{}, false, err
+	}
+	if !tresp.Succeeded {
+		return OpResponse{}, false, nil
+	}
+	// the responses within a txn share the header of the txn
+	if resp.get = tresp.GetRange(0); resp.get != nil {
+		resp.get.Header = tresp.Header
+	}
+	if resp.put = tresp.GetPut(0); resp.put != nil {
+		resp.put.Header = tresp.Header
+	}
+	if resp.del = tresp.GetDelete(0); resp.del != nil {
+		resp.del.Header = tresp.Header
+	}
+	return resp, true, nil
+}
+
 func (kv *kv) Put(ctx context.Context, key, val string, opts ...OpOption) (*PutResponse, error) {
 	r, err := kv.Do(ctx, OpPut(key, val, opts...))
 	return r.put, rpctypes.Error(err)
@@ -104,21 +463,58 @@ func (kv *kv) Delete(ctx context.Context, key string, opts ...OpOption) (*Delete
 	return r.del, rpctypes.Error(err)
 }
 
-func (kv *kv) Compact(ctx context.Context, rev int64) error {
-	remote, err := kv.getRemote(ctx)
+func (kv *kv) Compact(ctx context.Context, rev int64, opts ...CompactOption) (err error) {
+	start := time.Now()
+	defer func() { kv.rc.client.cfg.Metrics.observe("Compact", start, err) }()
+
+	var nl noLeaderRetry
+	for attempt := 1; ; attempt++ {
+		if cerr := ctx.Err(); cerr != nil {
+			return cerr
+		}
+		actx, cancel := kv.rc.client.withRequestTimeout(ctx)
+		hctx, end := kv.rc.client.startAttempt(actx, "Compact", nil, attempt)
+		conn, err := kv.compact(hctx, rev, opts...)
+		end(err)
+		cerr := actx.Err()
+		cancel()
+		if err == nil {
+			return nil
+		}
+		if cerr != nil {
+			return cerr
+		}
+		if rpctypes.Error(err) == rpctypes.ErrCompacted {
+			// compacted at or past rev, maybe by an earlier attempt
+			return nil
+		}
+		if kv.rc.client.cfg.FailFast {
+			return rpctypes.Error(err)
+		}
+		if isHaltErr(ctx, err) {
+			return rpctypes.Error(err)
+		}
+		if isNoLeaderErr(err) && nl.wait(ctx, kv.rc.client, false) {
+			continue
+		}
+		if nerr := kv.rc.client.retryWait(ctx, attempt, err); nerr != nil {
+			return nerr
+		}
+		if nerr := kv.rc.reconnectWaitFrom(ctx, conn, err); nerr != nil {
+			return rpctypes.Error(nerr)
+		}
+	}
+}
+
+// compact issues the compaction once, returning the connection it was sent on.
+func (kv *kv) compact(ctx context.Context, rev int64, opts ...CompactOption) (*grpc.ClientConn, error) {
+	remote, conn, err := kv.getRemote(ctx)
 	if err != nil {
-		return rpctypes.Error(err)
+		return nil, err
 	}
 	defer kv.rc.release()
-	_, err = remote.Compact(ctx, &pb.CompactionRequest{Revision: rev})
-	if err == nil {
-		return nil
-	}
-	if isHaltErr(ctx, err) {
-		return rpctypes.Error(err)
-	}
-	kv.rc.reconnect(err)
-	return rpctypes.Error(err)
+	_, err = remote.Compact(ctx, OpCompact(rev, opts...).toRequest())
+	return conn, err
 }
 
 func (kv *kv) Txn(ctx context.Context) Txn {
@@ -128,70 +524,175 @@ func (kv *kv) Txn(ctx context.Context) Txn {
 	}
 }
 
-func (kv *kv) Do(ctx context.Context, op Op) (OpResponse, error) {
-	for {
-		resp, err := kv.do(ctx, op)
+func (kv *kv) Do(ctx context.Context, op Op) (resp OpResponse, err error) {
+	start := time.Now()
+	defer func() { kv.rc.client.cfg.Metrics.observe(opMethod(op), start, err) }()
+
+	resp, err = kv.doRetry(ctx, op)
+	if err == nil && op.t == tRange && op.serializable && kv.rc.client.isStale(resp.Header()) {
+		// the member lags behind what the client has seen; read from the leader
+		op.serializable = false
+		resp, err = kv.doRetry(ctx, op)
+	}
+	return resp, err
+}
+
+// doRetry issues op, retrying reads on broken connections.
+func (kv *kv) doRetry(ctx context.Context, op Op) (resp OpResponse, err error) {
+	if op.requireLeader {
+		ctx = WithRequireLeader(ctx)
+	}
+	requireLeader := requiresLeader(ctx)
+	var nl noLeaderRetry
+	for attempt := 1; ; attempt++ {
+		if cerr := ctx.Err(); cerr != nil {
+			// canceled between attempts; don't issue another
+			return resp, cerr
+		}
+		actx, cancel := kv.rc.client.withRequestTimeout(ctx)
+		hctx, end := kv.rc.client.startAttempt(actx, opMethod(op), op.key, attempt)
+		resp, conn, err := kv.do(hctx, op)
+		end(err)
+		cerr := actx.Err()
+		cancel()
 		if err == nil {
+			kv.rc.client.observeRev(resp.Header())
 			return resp, nil
 		}
+		if cerr != nil {
+			// report the context error rather than the grpc error
+			return resp, cerr
+		}
+		if kv.rc.client.cfg.FailFast {
+			// leave the retry to the caller
+			return resp, rpctypes.Error(err)
+		}
 		if isHaltErr(ctx, err) {
 			return resp, rpctypes.Error(err)
 		}
+		if isNoLeaderErr(err) && requireLeader {
+			// the request was rejected, so even a write is safe to issue
+			// on a member that still has a leader
+			if !nl.failover(kv.rc.client) {
+				return resp, rpctypes.Error(err)
+			}
+			if nerr := kv.rc.reconnectWaitFrom(ctx, conn, err); nerr != nil {
+				return resp, rpctypes.Error(nerr)
+			}
+			continue
+		}
+		if isNoLeaderErr(err) && nl.wait(ctx, kv.rc.client, op.isWrite()) {
+			// the member may get a leader back soon; keep the connection
+			continue
+		}
 		// do not retry on modifications
 		if op.isWrite() {
-			kv.rc.reconnect(err)
+			kv.rc.reconnectFrom(conn, err)
 			return resp, rpctypes.Error(err)
 		}
-		if nerr := kv.rc.reconnectWait(ctx, err); nerr != nil {
+		if nerr := kv.rc.client.retryWait(ctx, attempt, err); nerr != nil {
+			return resp, nerr
+		}
+		if nerr := kv.rc.reconnectWaitFrom(ctx, conn, err); nerr != nil {
 			return resp, rpctypes.Error(nerr)
 		}
 	}
 }
 
-func (kv *kv) do(ctx context.Context, op Op) (OpResponse, error) {
-	remote, err := kv.getRemote(ctx)
+// do issues op once, returning the connection it was sent on.
+func (kv *kv) do(ctx context.Context, op Op) (OpResponse, *grpc.ClientConn, error) {
+	if op.t == tRange && op.serializable && kv.rc.client.cfg.ReadBalancer != nil {
+		if resp, conn, err := kv.doBalanced(ctx, op); conn != nil {
+			return resp, conn, err
+		}
+		// no connection to the picked endpoint; use the active one
+	}
+
+	remote, conn, err := kv.getRemote(ctx)
 	if err != nil {
-		return OpResponse{}, err
+		return OpResponse{}, nil, err
 	}
 	defer kv.rc.release()
 
 	switch op.t {
-	// TODO: handle other ops
 	case tRange:
 		var resp *pb.RangeResponse
-		r := &pb.RangeRequest{Key: op.key, RangeEnd: op.end, Limit: op.limit, Revision: op.rev, Serializable: op.serializable}
-		if op.sort != nil {
-			r.SortOrder = pb.RangeRequest_SortOrder(op.sort.Order)
-			r.SortTarget = pb.RangeRequest_SortTarget(op.sort.Target)
-		}
-
-		resp, err = remote.Range(ctx, r)
+		resp, err = remote.Range(ctx, op.toRangeRequest())
 		if err == nil {
-			return OpResponse{get: (*GetResponse)(resp)}, nil
+			return OpResponse{get: (*GetResponse)(resp)}, conn, nil
 		}
 	case tPut:
 		var resp *pb.PutResponse
-		r := &pb.PutRequest{Key: op.key, Value: op.val, Lease: int64(op.leaseID)}
-		resp, err = remote.Put(ctx, r)
+		resp, err = remote.Put(ctx, op.toPutRequest())
 		if err == nil {
-			return OpResponse{put: (*PutResponse)(resp)}, nil
+			return OpResponse{put: (*PutResponse)(resp)}, conn, nil
 		}
 	case tDeleteRange:
 		var resp *pb.DeleteRangeResponse
-		r := &pb.DeleteRangeRequest{Key: op.key, RangeEnd: op.end}
-		resp, err = remote.DeleteRange(ctx, r)
+		resp, err = remote.DeleteRange(ctx, op.toDeleteRangeRequest())
 		if err == nil {
-			return OpResponse{del: (*DeleteResponse)(resp)}, nil
+			return OpResponse{del: (*DeleteResponse)(resp)}, conn, nil
 		}
 	default:
 		panic("Unknown op")
 	}
-	return OpResponse{}, err
+	return OpResponse{}, conn, err
 }
 
-func (kv *kv) getRemote(ctx context.Context) (pb.KVClient, error) {
-	if err := kv.rc.acquire(ctx); err != nil {
-		return nil, err
+// doBalanced issues the serializable range op on the endpoint picked by the
+// client's ReadBalancer. It returns a nil connection if the endpoint is not
+// connected yet or its connection is not ready.
+func (kv *kv) doBalanced(ctx context.Context, op Op) (OpResponse, *grpc.ClientConn, error) {
+	c := kv.rc.client
+	ep, conn, err := c.readConn()
+	if err != nil {
+		return OpResponse{}, nil, err
+	}
+	if st, serr := conn.State(); serr != nil || st != grpc.Ready {
+		// requests would wait for the connection to come back
+		c.readFailed(ep, conn)
+		return OpResponse{}, nil, errConnUnhealthy
+	}
+	remote := c.retryKVClient(c.metricsKVClient(pb.NewKVClient(conn)))
+	resp, err := remote.Range(ctx, op.toRangeRequest())
+	if err != nil {
+		if !isHaltErr(ctx, err) {
+			c.readFailed(ep, conn)
+		}
+		return OpResponse{}, conn, err
+	}
+	return OpResponse{get: (*GetResponse)(resp)}, conn, nil
+}
+
+// observeRev records the revision of a KV response header if it is the
+// highest the client has seen.
+func (c *Client) observeRev(h *pb.ResponseHeader) {
+	if h == nil {
+		return
+	}
+	for {
+		rev := atomic.LoadInt64(&c.rev)
+		if h.Revision <= rev || atomic.CompareAndSwapInt64(&c.rev, rev, h.Revision) {
+			return
+		}
+	}
+}
+
+// isStale returns true if the response header's revision is more than
+// MaxStaleness behind the highest revision the client has seen.
+func (c *Client) isStale(h *pb.ResponseHeader) bool {
+	if c.cfg.MaxStaleness <= 0 || h == nil {
+		return false
+	}
+	return h.Revision < atomic.LoadInt64(&c.rev)-c.cfg.MaxStaleness
+}
+
+// getRemote returns the KV client for the active connection along with the
+// connection; the caller must release it when done.
+func (kv *kv) getRemote(ctx context.Context) (pb.KVClient, *grpc.ClientConn, error) {
+	conn, err := kv.rc.acquire(ctx)
+	if err != nil {
+		return nil, nil, err
 	}
-	return kv.remote, nil
+	return kv.remote, conn, nil
 }
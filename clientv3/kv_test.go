@@ -0,0 +1,140 @@
+// Copyright 2016 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clientv3
+
+import (
+	"reflect"
+	"testing"
+
+	pb "github.com/coreos/etcd/etcdserver/etcdserverpb"
+	"github.com/coreos/etcd/mvcc/mvccpb"
+)
+
+func TestGetResponseAccessors(t *testing.T) {
+	kvs := []*mvccpb.KeyValue{
+		{Key: []byte("a"), Value: []byte("1")},
+		{Key: []byte("b"), Value: []byte("2")},
+	}
+	resp := &GetResponse{Kvs: kvs}
+	if keys := resp.Keys(); !reflect.DeepEqual(keys, []string{"a", "b"}) {
+		t.Errorf("keys = %v, want [a b]", keys)
+	}
+	if vals := resp.Values(); !reflect.DeepEqual(vals, []string{"1", "2"}) {
+		t.Errorf("values = %v, want [1 2]", vals)
+	}
+	if kv := resp.First(); kv != kvs[0] {
+		t.Errorf("first = %+v, want %+v", kv, kvs[0])
+	}
+
+	empty := &GetResponse{}
+	if keys := empty.Keys(); len(keys) != 0 {
+		t.Errorf("keys = %v, want empty", keys)
+	}
+	if vals := empty.Values(); len(vals) != 0 {
+		t.Errorf("values = %v, want empty", vals)
+	}
+	if kv := empty.First(); kv != nil {
+		t.Errorf("first = %+v, want nil", kv)
+	}
+}
+
+func TestTxnResponseAccessors(t *testing.T) {
+	get := &pb.RangeResponse{Count: 1}
+	put := &pb.PutResponse{}
+	del := &pb.DeleteRangeResponse{Deleted: 2}
+	resp := &TxnResponse{Responses: []*pb.ResponseUnion{
+		{Response: &pb.ResponseUnion_ResponseRange{ResponseRange: get}},
+		{Response: &pb.ResponseUnion_ResponsePut{ResponsePut: put}},
+		{Response: &pb.ResponseUnion_ResponseDeleteRange{ResponseDeleteRange: del}},
+		nil,
+	}}
+	tests := []struct {
+		i int
+
+		wget *GetResponse
+		wput *PutResponse
+		wdel *DeleteResponse
+	}{
+		{0, (*GetResponse)(get), nil, nil},
+		{1, nil, (*PutResponse)(put), nil},
+		{2, nil, nil, (*DeleteResponse)(del)},
+		{3, nil, nil, nil},
+		{4, nil, nil, nil},
+		{-1, nil, nil, nil},
+	}
+	for i, tt := range tests {
+		if g := resp.GetRange(tt.i); g != tt.wget {
+			t.Errorf("#%d: get = %+v, want %+v", i, g, tt.wget)
+		}
+		if p := resp.GetPut(tt.i); p != tt.wput {
+			t.Errorf("#%d: put = %+v, want %+v", i, p, tt.wput)
+		}
+		if d := resp.GetDelete(tt.i); d != tt.wdel {
+			t.Errorf("#%d: delete = %+v, want %+v", i, d, tt.wdel)
+		}
+	}
+	if g := (*TxnResponse)(nil).GetRange(0); g != nil {
+		t.Errorf("get = %+v, want nil", g)
+	}
+}
+
+func TestResponseHeaders(t *testing.T) {
+	h := &pb.ResponseHeader{ClusterId: 1, MemberId: 2, Revision: 3, RaftTerm: 4}
+	tests := []struct {
+		h *pb.ResponseHeader
+		w *pb.ResponseHeader
+	}{
+		{(&PutResponse{Header: h}).GetHeader(), h},
+		{(&GetResponse{Header: h}).GetHeader(), h},
+		{(&DeleteResponse{Header: h}).GetHeader(), h},
+		{(&TxnResponse{Header: h}).GetHeader(), h},
+		{OpResponse{get: &GetResponse{Header: h}}.Header(), h},
+		{OpResponse{del: &DeleteResponse{Header: h}}.Header(), h},
+		{(*PutResponse)(nil).GetHeader(), nil},
+		{OpResponse{}.Header(), nil},
+	}
+	for i, tt := range tests {
+		if tt.h != tt.w {
+			t.Errorf("#%d: header = %+v, want %+v", i, tt.h, tt.w)
+		}
+	}
+}
+
+func TestClientStaleness(t *testing.T) {
+	c := &Client{cfg: Config{MaxStaleness: 2}}
+	c.observeRev(&pb.ResponseHeader{Revision: 10})
+	c.observeRev(&pb.ResponseHeader{Revision: 5})
+	c.observeRev(nil)
+
+	tests := []struct {
+		rev    int64
+		wstale bool
+	}{
+		{7, true},
+		{8, false},
+		{10, false},
+		{12, false},
+	}
+	for i, tt := range tests {
+		if stale := c.isStale(&pb.ResponseHeader{Revision: tt.rev}); stale != tt.wstale {
+			t.Errorf("#%d: stale = %v, want %v", i, stale, tt.wstale)
+		}
+	}
+
+	c.cfg.MaxStaleness = 0
+	if c.isStale(&pb.ResponseHeader{Revision: 1}) {
+		t.Errorf("stale with MaxStaleness disabled")
+	}
+}
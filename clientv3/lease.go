@@ -20,6 +20,7 @@ import (
 
 	"github.com/coreos/etcd/etcdserver/api/v3rpc/rpctypes"
 	pb "github.com/coreos/etcd/etcdserver/etcdserverpb"
+	"github.com/coreos/etcd/mvcc/mvccpb"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 )
@@ -44,6 +45,51 @@ type LeaseKeepAliveResponse struct {
 	TTL int64
 }
 
+// LeaseTimeToLiveResponse is used to convert the protobuf lease timetolive response.
+type LeaseTimeToLiveResponse struct {
+	*pb.ResponseHeader
+	ID LeaseID
+	// TTL is the remaining TTL in seconds for the lease; it is not
+	// positive if the lease has expired or does not exist.
+	TTL int64
+	// GrantedTTL is the initial granted time in seconds upon lease creation/renewal.
+	GrantedTTL int64
+	// Keys is the list of keys attached to this lease, if requested
+	// with WithAttachedKeys.
+	Keys [][]byte
+}
+
+// LeaseStatus represents a lease status.
+type LeaseStatus struct {
+	ID LeaseID
+}
+
+// LeaseLeasesResponse is used to convert the protobuf lease list response.
+type LeaseLeasesResponse struct {
+	*pb.ResponseHeader
+	Leases []LeaseStatus
+}
+
+// LeaseOp represents an operation on a lease.
+type LeaseOp struct {
+	attachedKeys bool
+}
+
+// LeaseOption configures lease operations.
+type LeaseOption func(*LeaseOp)
+
+func (op *LeaseOp) applyOpts(opts []LeaseOption) {
+	for _, opt := range opts {
+		opt(op)
+	}
+}
+
+// WithAttachedKeys requests lease timetolive API to return
+// the keys attached to the given lease ID.
+func WithAttachedKeys() LeaseOption {
+	return func(op *LeaseOp) { op.attachedKeys = true }
+}
+
 const (
 	// a small buffer to store unsent lease responses.
 	leaseResponseChSize = 16
@@ -51,18 +97,43 @@ const (
 	NoLease LeaseID = 0
 )
 
+// LeaseOf returns the ID of the lease the key-value is attached to, or
+// NoLease if it has none.
+func LeaseOf(kv *mvccpb.KeyValue) LeaseID {
+	if kv == nil {
+		return NoLease
+	}
+	return LeaseID(kv.Lease)
+}
+
 type Lease interface {
-	// Grant creates a new lease.
+	// Grant creates a new lease with the given TTL in seconds. The ID of
+	// the granted lease can be attached to keys with WithLease.
 	Grant(ctx context.Context, ttl int64) (*LeaseGrantResponse, error)
 
-	// Revoke revokes the given lease.
+	// Revoke revokes the given lease. All keys attached to the lease
+	// are deleted.
 	Revoke(ctx context.Context, id LeaseID) (*LeaseRevokeResponse, error)
 
-	// KeepAlive keeps the given lease alive forever.
+	// TimeToLive retrieves the remaining TTL of the given lease, and the
+	// keys attached to it if WithAttachedKeys is given. A lease that has
+	// expired or does not exist is reported with a TTL that is not
+	// positive rather than with an error.
+	TimeToLive(ctx context.Context, id LeaseID, opts ...LeaseOption) (*LeaseTimeToLiveResponse, error)
+
+	// Leases lists the IDs of all leases in the cluster, as known to the
+	// member the client is connected to.
+	Leases(ctx context.Context) (*LeaseLeasesResponse, error)
+
+	// KeepAlive keeps the given lease alive forever. The returned channel
+	// receives the response of each renewal; it is closed when ctx is
+	// canceled, the lessor is closed, or the lease is lost because it was
+	// revoked, expired, or could not be renewed within its TTL.
 	KeepAlive(ctx context.Context, id LeaseID) (<-chan *LeaseKeepAliveResponse, error)
 
-	// KeepAliveOnce renews the lease once. In most of the cases, Keepalive
-	// should be used instead of KeepAliveOnce.
+	// KeepAliveOnce renews the lease once. It returns ErrLeaseNotFound if
+	// the lease has already expired or was revoked. In most of the cases,
+	// Keepalive should be used instead of KeepAliveOnce.
 	KeepAliveOnce(ctx context.Context, id LeaseID) (*LeaseKeepAliveResponse, error)
 
 	// Close releases all resources Lease keeps for efficient communication
@@ -92,25 +163,49 @@ type lessor struct {
 type keepAlive struct {
 	chs  []chan<- *LeaseKeepAliveResponse
 	ctxs []context.Context
-	// deadline is the next time to send a keep alive message
+	// nextKeepAlive is the next time to send a keep alive message
+	nextKeepAlive time.Time
+	// deadline is the time the lease expires if no keep alive response
+	// arrives before then; zero until the first response is received
 	deadline time.Time
 	// donec is closed on lease revoke, expiration, or cancel.
 	donec chan struct{}
 }
 
 func NewLease(c *Client) Lease {
+	l := newLessor()
+	f := func(conn *grpc.ClientConn) { l.remote = pb.NewLeaseClient(conn) }
+	l.rc = newRemoteClient(c, f)
+	l.start()
+	return l
+}
+
+// NewLeaseFromLeaseClient returns a Lease that issues its requests to remote
+// instead of over a client connection, e.g., to test code using Lease
+// against a fake remote. Requests are retried on remote as the client given
+// cfg would retry them; its Endpoints and connection settings are ignored.
+func NewLeaseFromLeaseClient(remote pb.LeaseClient, cfg Config) Lease {
+	c := newDetachedClient(cfg)
+	l := newLessor()
+	l.remote = remote
+	l.rc = newFixedRemoteClient(c)
+	l.start()
+	return l
+}
+
+func newLessor() *lessor {
 	l := &lessor{
 		donec:      make(chan struct{}),
 		keepAlives: make(map[LeaseID]*keepAlive),
 	}
-	f := func(conn *grpc.ClientConn) { l.remote = pb.NewLeaseClient(conn) }
-	l.rc = newRemoteClient(c, f)
-
 	l.stopCtx, l.stopCancel = context.WithCancel(context.Background())
+	return l
+}
 
+// start runs the loops serving the keep alives of the lessor.
+func (l *lessor) start() {
 	go l.recvKeepAliveLoop()
-
-	return l
+	go l.deadlineLoop()
 }
 
 func (l *lessor) Grant(ctx context.Context, ttl int64) (*LeaseGrantResponse, error) {
@@ -118,9 +213,11 @@ func (l *lessor) Grant(ctx context.Context, ttl int64) (*LeaseGrantResponse, err
 	done := cancelWhenStop(cancel, l.stopCtx.Done())
 	defer close(done)
 
-	for {
+	for attempt := 1; ; attempt++ {
 		r := &pb.LeaseGrantRequest{TTL: ttl}
-		resp, err := l.getRemote().LeaseGrant(cctx, r)
+		hctx, end := l.rc.client.startAttempt(cctx, "LeaseGrant", nil, attempt)
+		resp, err := l.getRemote().LeaseGrant(hctx, r)
+		end(err)
 		if err == nil {
 			gresp := &LeaseGrantResponse{
 				ResponseHeader: resp.GetHeader(),
@@ -134,6 +231,9 @@ func (l *lessor) Grant(ctx context.Context, ttl int64) (*LeaseGrantResponse, err
 			return nil, rpctypes.Error(err)
 		}
 
+		if !l.rc.client.retries.withdraw() {
+			return nil, rpctypes.Error(err)
+		}
 		if nerr := l.switchRemoteAndStream(err); nerr != nil {
 			return nil, nerr
 		}
@@ -145,17 +245,86 @@ func (l *lessor) Revoke(ctx context.Context, id LeaseID) (*LeaseRevokeResponse,
 	done := cancelWhenStop(cancel, l.stopCtx.Done())
 	defer close(done)
 
-	for {
+	for attempt := 1; ; attempt++ {
 		r := &pb.LeaseRevokeRequest{ID: int64(id)}
-		resp, err := l.getRemote().LeaseRevoke(cctx, r)
+		hctx, end := l.rc.client.startAttempt(cctx, "LeaseRevoke", nil, attempt)
+		resp, err := l.getRemote().LeaseRevoke(hctx, r)
+		end(err)
 
 		if err == nil {
 			return (*LeaseRevokeResponse)(resp), nil
 		}
-		if isHaltErr(ctx, err) {
+		if isHaltErr(cctx, err) {
 			return nil, rpctypes.Error(err)
 		}
 
+		if !l.rc.client.retries.withdraw() {
+			return nil, rpctypes.Error(err)
+		}
+		if nerr := l.switchRemoteAndStream(err); nerr != nil {
+			return nil, nerr
+		}
+	}
+}
+
+func (l *lessor) TimeToLive(ctx context.Context, id LeaseID, opts ...LeaseOption) (*LeaseTimeToLiveResponse, error) {
+	cctx, cancel := context.WithCancel(ctx)
+	done := cancelWhenStop(cancel, l.stopCtx.Done())
+	defer close(done)
+
+	ret := &LeaseOp{}
+	ret.applyOpts(opts)
+	for attempt := 1; ; attempt++ {
+		r := &pb.LeaseTimeToLiveRequest{ID: int64(id), Keys: ret.attachedKeys}
+		hctx, end := l.rc.client.startAttempt(cctx, "LeaseTimeToLive", nil, attempt)
+		resp, err := l.getRemote().LeaseTimeToLive(hctx, r)
+		end(err)
+		if err == nil {
+			tresp := &LeaseTimeToLiveResponse{
+				ResponseHeader: resp.GetHeader(),
+				ID:             LeaseID(resp.ID),
+				TTL:            resp.TTL,
+				GrantedTTL:     resp.GrantedTTL,
+				Keys:           resp.Keys,
+			}
+			return tresp, nil
+		}
+		if isHaltErr(cctx, err) {
+			return nil, rpctypes.Error(err)
+		}
+
+		if !l.rc.client.retries.withdraw() {
+			return nil, rpctypes.Error(err)
+		}
+		if nerr := l.switchRemoteAndStream(err); nerr != nil {
+			return nil, nerr
+		}
+	}
+}
+
+func (l *lessor) Leases(ctx context.Context) (*LeaseLeasesResponse, error) {
+	cctx, cancel := context.WithCancel(ctx)
+	done := cancelWhenStop(cancel, l.stopCtx.Done())
+	defer close(done)
+
+	for attempt := 1; ; attempt++ {
+		hctx, end := l.rc.client.startAttempt(cctx, "LeaseLeases", nil, attempt)
+		resp, err := l.getRemote().LeaseLeases(hctx, &pb.LeaseLeasesRequest{})
+		end(err)
+		if err == nil {
+			leases := make([]LeaseStatus, len(resp.Leases))
+			for i := range resp.Leases {
+				leases[i] = LeaseStatus{ID: LeaseID(resp.Leases[i].ID)}
+			}
+			return &LeaseLeasesResponse{ResponseHeader: resp.GetHeader(), Leases: leases}, nil
+		}
+		if isHaltErr(cctx, err) {
+			return nil, rpctypes.Error(err)
+		}
+
+		if !l.rc.client.retries.withdraw() {
+			return nil, rpctypes.Error(err)
+		}
 		if nerr := l.switchRemoteAndStream(err); nerr != nil {
 			return nil, nerr
 		}
@@ -170,10 +339,10 @@ func (l *lessor) KeepAlive(ctx context.Context, id LeaseID) (<-chan *LeaseKeepAl
 	if !ok {
 		// create fresh keep alive
 		ka = &keepAlive{
-			chs:      []chan<- *LeaseKeepAliveResponse{ch},
-			ctxs:     []context.Context{ctx},
-			deadline: time.Now(),
-			donec:    make(chan struct{}),
+			chs:           []chan<- *LeaseKeepAliveResponse{ch},
+			ctxs:          []context.Context{ctx},
+			nextKeepAlive: time.Now(),
+			donec:         make(chan struct{}),
 		}
 		l.keepAlives[id] = ka
 	} else {
@@ -193,18 +362,23 @@ func (l *lessor) KeepAliveOnce(ctx context.Context, id LeaseID) (*LeaseKeepAlive
 	done := cancelWhenStop(cancel, l.stopCtx.Done())
 	defer close(done)
 
-	for {
-		resp, err := l.keepAliveOnce(cctx, id)
+	for attempt := 1; ; attempt++ {
+		hctx, end := l.rc.client.startAttempt(cctx, "LeaseKeepAlive", nil, attempt)
+		resp, err := l.keepAliveOnce(hctx, id)
+		end(err)
 		if err == nil {
-			if resp.TTL == 0 {
+			if resp.TTL <= 0 {
 				err = rpctypes.ErrLeaseNotFound
 			}
 			return resp, err
 		}
-		if isHaltErr(ctx, err) {
+		if isHaltErr(cctx, err) {
 			return nil, rpctypes.Error(err)
 		}
 
+		if !l.rc.client.retries.withdraw() {
+			return nil, rpctypes.Error(err)
+		}
 		nerr := l.switchRemoteAndStream(err)
 		if nerr != nil {
 			return nil, nerr
@@ -336,16 +510,41 @@ func (l *lessor) recvKeepAlive(resp *pb.LeaseKeepAliveResponse) {
 	}
 
 	// send update to all channels
-	nextDeadline := time.Now().Add(1 + time.Duration(karesp.TTL/3)*time.Second)
+	nextKeepAlive := time.Now().Add(1 + time.Duration(karesp.TTL/3)*time.Second)
+	ka.deadline = time.Now().Add(time.Duration(karesp.TTL) * time.Second)
 	for _, ch := range ka.chs {
 		select {
 		case ch <- karesp:
-			ka.deadline = nextDeadline
+			ka.nextKeepAlive = nextKeepAlive
 		default:
 		}
 	}
 }
 
+// deadlineLoop reaps any keep alive channels that have not received a
+// response within the lease TTL; the lease may have expired on the server.
+func (l *lessor) deadlineLoop() {
+	for {
+		select {
+		case <-time.After(time.Second):
+		case <-l.donec:
+			return
+		case <-l.stopCtx.Done():
+			return
+		}
+		now := time.Now()
+		l.mu.Lock()
+		for id, ka := range l.keepAlives {
+			if !ka.deadline.IsZero() && ka.deadline.Before(now) {
+				// waited too long for response; lease may be expired
+				ka.Close()
+				delete(l.keepAlives, id)
+			}
+		}
+		l.mu.Unlock()
+	}
+}
+
 // sendKeepAliveLoop sends LeaseKeepAliveRequests for the lifetime of a lease stream
 func (l *lessor) sendKeepAliveLoop(stream pb.Lease_LeaseKeepAliveClient) {
 	for {
@@ -364,7 +563,7 @@ func (l *lessor) sendKeepAliveLoop(stream pb.Lease_LeaseKeepAliveClient) {
 		now := time.Now()
 		l.mu.Lock()
 		for id, ka := range l.keepAlives {
-			if ka.deadline.Before(now) {
+			if ka.nextKeepAlive.Before(now) {
 				tosend = append(tosend, id)
 			}
 		}
@@ -408,7 +607,7 @@ func (l *lessor) switchRemoteAndStream(prevErr error) error {
 
 func (l *lessor) newStream() error {
 	sctx, cancel := context.WithCancel(l.stopCtx)
-	stream, err := l.getRemote().LeaseKeepAlive(sctx)
+	stream, err := l.getRemote().LeaseKeepAlive(l.rc.client.withMetadata(sctx))
 	if err != nil {
 		cancel()
 		return rpctypes.Error(err)
@@ -34,7 +34,9 @@ type Maintenance interface {
 	// AlarmList gets all active alarms.
 	AlarmList(ctx context.Context) (*AlarmResponse, error)
 
-	// AlarmDisarm disarms a given alarm.
+	// AlarmDisarm disarms a given alarm. An empty AlarmMember disarms all
+	// active alarms. To recover from a NOSPACE alarm, compact and defragment
+	// the members before disarming it.
 	AlarmDisarm(ctx context.Context, m *AlarmMember) (*AlarmResponse, error)
 
 	// Defragment defragments storage backend of the etcd member with given endpoint.
@@ -44,13 +46,23 @@ type Maintenance interface {
 	// at the same time.
 	// To defragment multiple members in the cluster, user need to call defragment multiple
 	// times with different endpoints.
+	// Defragment blocks until the member finishes; the ctx deadline bounds how long
+	// the client waits for it.
 	Defragment(ctx context.Context, endpoint string) (*DefragmentResponse, error)
 
-	// Status gets the status of the endpoint.
+	// Status gets the status of the endpoint. It dials the given endpoint on
+	// its own connection, so the reported status is that member's own view.
 	Status(ctx context.Context, endpoint string) (*StatusResponse, error)
 
-	// Snapshot provides a reader for a snapshot of a backend.
+	// Snapshot provides a reader for a snapshot of a backend. The stream ends
+	// with the sha256 checksum of the backend data. Closing the reader or
+	// canceling ctx stops the stream.
 	Snapshot(ctx context.Context) (io.ReadCloser, error)
+
+	// MoveLeader requests current leader to transfer its leadership to the member
+	// with targetID. The request must be made to the leader; otherwise it fails
+	// with rpctypes.ErrNotLeader.
+	MoveLeader(ctx context.Context, targetID uint64) error
 }
 
 type maintenance struct {
@@ -125,6 +137,7 @@ func (m *maintenance) Defragment(ctx context.Context, endpoint string) (*Defragm
 	if err != nil {
 		return nil, rpctypes.Error(err)
 	}
+	defer conn.Close()
 	remote := pb.NewMaintenanceClient(conn)
 	resp, err := remote.Defragment(ctx, &pb.DefragmentRequest{})
 	if err != nil {
@@ -138,6 +151,7 @@ func (m *maintenance) Status(ctx context.Context, endpoint string) (*StatusRespo
 	if err != nil {
 		return nil, rpctypes.Error(err)
 	}
+	defer conn.Close()
 	remote := pb.NewMaintenanceClient(conn)
 	resp, err := remote.Status(ctx, &pb.StatusRequest{})
 	if err != nil {
@@ -147,8 +161,10 @@ func (m *maintenance) Status(ctx context.Context, endpoint string) (*StatusRespo
 }
 
 func (m *maintenance) Snapshot(ctx context.Context) (io.ReadCloser, error) {
+	ctx, cancel := context.WithCancel(ctx)
 	ss, err := m.getRemote().Snapshot(ctx, &pb.SnapshotRequest{})
 	if err != nil {
+		cancel()
 		return nil, rpctypes.Error(err)
 	}
 
@@ -157,20 +173,38 @@ func (m *maintenance) Snapshot(ctx context.Context) (io.ReadCloser, error) {
 		for {
 			resp, err := ss.Recv()
 			if err != nil {
+				if err != io.EOF {
+					err = rpctypes.Error(err)
+				}
+				if cerr := ctx.Err(); cerr != nil {
+					err = cerr
+				}
 				pw.CloseWithError(err)
 				return
 			}
-			if resp == nil && err == nil {
-				break
-			}
 			if _, werr := pw.Write(resp.Blob); werr != nil {
 				pw.CloseWithError(werr)
 				return
 			}
 		}
-		pw.Close()
 	}()
-	return pr, nil
+	return &snapshotReadCloser{PipeReader: pr, cancel: cancel}, nil
+}
+
+// snapshotReadCloser cancels the snapshot stream once the reader is closed.
+type snapshotReadCloser struct {
+	*io.PipeReader
+	cancel context.CancelFunc
+}
+
+func (rc *snapshotReadCloser) Close() error {
+	rc.cancel()
+	return rc.PipeReader.Close()
+}
+
+func (m *maintenance) MoveLeader(ctx context.Context, targetID uint64) error {
+	_, err := m.getRemote().MoveLeader(ctx, &pb.MoveLeaderRequest{TargetID: targetID})
+	return rpctypes.Error(err)
 }
 
 func (m *maintenance) getRemote() pb.MaintenanceClient {
@@ -0,0 +1,172 @@
+// Copyright 2016 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clientv3
+
+import (
+	"time"
+
+	"github.com/coreos/etcd/etcdserver/api/v3rpc/rpctypes"
+	pb "github.com/coreos/etcd/etcdserver/etcdserverpb"
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// Metrics holds prometheus collectors for the KV requests of a client. Set
+// it as Config.Metrics and register it with prometheus to export them.
+//
+// A request is counted once when it returns, however many RPC attempts it
+// took; each attempt, including retries on a new connection, is counted
+// separately.
+type Metrics struct {
+	handled  *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+	attempts *prometheus.CounterVec
+}
+
+// NewMetrics creates unregistered client metrics.
+func NewMetrics() *Metrics {
+	return &Metrics{
+		handled: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: "etcd",
+				Subsystem: "client",
+				Name:      "requests_total",
+				Help:      "Counter of completed client requests.",
+			}, []string{"grpc_method", "grpc_code"}),
+
+		duration: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Namespace: "etcd",
+				Subsystem: "client",
+				Name:      "request_duration_seconds",
+				Help:      "Bucketed histogram of client request time (s), including retries.",
+				Buckets:   prometheus.ExponentialBuckets(0.0005, 2, 13),
+			}, []string{"grpc_method"}),
+
+		attempts: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: "etcd",
+				Subsystem: "client",
+				Name:      "rpc_attempts_total",
+				Help:      "Counter of RPCs sent for client requests.",
+			}, []string{"grpc_method", "grpc_code"}),
+	}
+}
+
+// Describe implements prometheus.Collector.
+func (m *Metrics) Describe(ch chan<- *prometheus.Desc) {
+	m.handled.Describe(ch)
+	m.duration.Describe(ch)
+	m.attempts.Describe(ch)
+}
+
+// Collect implements prometheus.Collector.
+func (m *Metrics) Collect(ch chan<- prometheus.Metric) {
+	m.handled.Collect(ch)
+	m.duration.Collect(ch)
+	m.attempts.Collect(ch)
+}
+
+// observe records a request for method that started at start and
+// returned err. It is a no-op on nil Metrics.
+func (m *Metrics) observe(method string, start time.Time, err error) {
+	if m == nil {
+		return
+	}
+	m.handled.WithLabelValues(method, errCode(err).String()).Inc()
+	m.duration.WithLabelValues(method).Observe(time.Since(start).Seconds())
+}
+
+// observeAttempt records a single RPC for method that returned err.
+func (m *Metrics) observeAttempt(method string, err error) {
+	m.attempts.WithLabelValues(method, errCode(err).String()).Inc()
+}
+
+// errCode returns the grpc code that err carries.
+func errCode(err error) codes.Code {
+	switch e := err.(type) {
+	case nil:
+		return codes.OK
+	case rpctypes.EtcdError:
+		return e.Code()
+	case *RetryError:
+		return errCode(e.Err)
+	}
+	switch err {
+	case context.Canceled:
+		return codes.Canceled
+	case context.DeadlineExceeded:
+		return codes.DeadlineExceeded
+	}
+	return grpc.Code(err)
+}
+
+// opMethod returns the name of the RPC that issues op.
+func opMethod(op Op) string {
+	switch op.t {
+	case tRange:
+		return "Range"
+	case tPut:
+		return "Put"
+	case tDeleteRange:
+		return "DeleteRange"
+	}
+	return "Unknown"
+}
+
+type metricsKVClient struct {
+	pb.KVClient
+	m *Metrics
+}
+
+// metricsKVClient wraps kc to count its RPCs, if Config.Metrics is set.
+func (c *Client) metricsKVClient(kc pb.KVClient) pb.KVClient {
+	if c.cfg.Metrics == nil {
+		return kc
+	}
+	return &metricsKVClient{KVClient: kc, m: c.cfg.Metrics}
+}
+
+func (mkv *metricsKVClient) Range(ctx context.Context, in *pb.RangeRequest, opts ...grpc.CallOption) (*pb.RangeResponse, error) {
+	resp, err := mkv.KVClient.Range(ctx, in, opts...)
+	mkv.m.observeAttempt("Range", err)
+	return resp, err
+}
+
+func (mkv *metricsKVClient) Put(ctx context.Context, in *pb.PutRequest, opts ...grpc.CallOption) (*pb.PutResponse, error) {
+	resp, err := mkv.KVClient.Put(ctx, in, opts...)
+	mkv.m.observeAttempt("Put", err)
+	return resp, err
+}
+
+func (mkv *metricsKVClient) DeleteRange(ctx context.Context, in *pb.DeleteRangeRequest, opts ...grpc.CallOption) (*pb.DeleteRangeResponse, error) {
+	resp, err := mkv.KVClient.DeleteRange(ctx, in, opts...)
+	mkv.m.observeAttempt("DeleteRange", err)
+	return resp, err
+}
+
+func (mkv *metricsKVClient) Txn(ctx context.Context, in *pb.TxnRequest, opts ...grpc.CallOption) (*pb.TxnResponse, error) {
+	resp, err := mkv.KVClient.Txn(ctx, in, opts...)
+	mkv.m.observeAttempt("Txn", err)
+	return resp, err
+}
+
+func (mkv *metricsKVClient) Compact(ctx context.Context, in *pb.CompactionRequest, opts ...grpc.CallOption) (*pb.CompactionResponse, error) {
+	resp, err := mkv.KVClient.Compact(ctx, in, opts...)
+	mkv.m.observeAttempt("Compact", err)
+	return resp, err
+}
@@ -0,0 +1,90 @@
+// Copyright 2016 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clientv3
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/coreos/etcd/etcdserver/api/v3rpc/rpctypes"
+	pb "github.com/coreos/etcd/etcdserver/etcdserverpb"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+func counterValue(t *testing.T, cv *prometheus.CounterVec, labels ...string) float64 {
+	var m dto.Metric
+	if err := cv.WithLabelValues(labels...).Write(&m); err != nil {
+		t.Fatal(err)
+	}
+	return m.GetCounter().GetValue()
+}
+
+func TestMetricsKVClient(t *testing.T) {
+	m := NewMetrics()
+	c := &Client{cfg: Config{RetryRPCs: true, RetryPolicy: &RetryPolicy{}, Metrics: m}}
+	fkc := &fakeKVClient{errs: []error{rpctypes.ErrGRPCNoLeader, rpctypes.ErrGRPCNoLeader}}
+	kc := c.retryKVClient(c.metricsKVClient(fkc))
+
+	start := time.Now()
+	_, err := kc.Range(context.TODO(), &pb.RangeRequest{})
+	m.observe("Range", start, err)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if v := counterValue(t, m.handled, "Range", "OK"); v != 1 {
+		t.Errorf("handled = %v, want 1", v)
+	}
+	if v := counterValue(t, m.attempts, "Range", "Unavailable"); v != 2 {
+		t.Errorf("failed attempts = %v, want 2", v)
+	}
+	if v := counterValue(t, m.attempts, "Range", "OK"); v != 1 {
+		t.Errorf("successful attempts = %v, want 1", v)
+	}
+
+	// no-op without metrics
+	var nm *Metrics
+	nm.observe("Range", start, nil)
+	c = &Client{}
+	if kc := c.metricsKVClient(fkc); kc != pb.KVClient(fkc) {
+		t.Errorf("expected unwrapped client without metrics")
+	}
+}
+
+func TestErrCode(t *testing.T) {
+	tests := []struct {
+		err error
+		w   codes.Code
+	}{
+		{nil, codes.OK},
+		{context.Canceled, codes.Canceled},
+		{context.DeadlineExceeded, codes.DeadlineExceeded},
+		{rpctypes.ErrCompacted, codes.OutOfRange},
+		{rpctypes.ErrGRPCNoLeader, codes.Unavailable},
+		{&RetryError{Attempts: 2, Err: rpctypes.ErrNoLeader}, codes.Unavailable},
+		{grpc.Errorf(codes.Internal, "internal"), codes.Internal},
+		{errors.New("other"), codes.Unknown},
+	}
+	for i, tt := range tests {
+		if g := errCode(tt.err); g != tt.w {
+			t.Errorf("#%d: errCode(%v) = %v, want %v", i, tt.err, g, tt.w)
+		}
+	}
+}
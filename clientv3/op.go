@@ -15,6 +15,8 @@
 package clientv3
 
 import (
+	"time"
+
 	pb "github.com/coreos/etcd/etcdserver/etcdserverpb"
 )
 
@@ -41,33 +43,127 @@ type Op struct {
 	limit        int64
 	sort         *SortOption
 	serializable bool
+	countOnly    bool
+	keysOnly     bool
+	minCreateRev int64
+	maxCreateRev int64
+	minModRev    int64
+	maxModRev    int64
 
 	// for range, watch
 	rev int64
 
 	// progressNotify is for progress updates.
 	progressNotify bool
+	// filters for watchers
+	filterPut    bool
+	filterDelete bool
+	// batching of watch events
+	batchEvents bool
+	batchDelay  time.Duration
+	batchCount  int
+	// snapshotRecovery restarts a compacted watch from a Get
+	snapshotRecovery bool
+
+	// for put, delete, watch
+	prevKV bool
 
 	// for put
-	val     []byte
-	leaseID LeaseID
+	val         []byte
+	leaseID     LeaseID
+	ignoreValue bool
+	ignoreLease bool
+
+	// requireLeader fails the request if the member has no leader
+	requireLeader bool
+}
+
+// IsGet returns true iff the operation is a Get.
+func (op Op) IsGet() bool { return op.t == tRange }
+
+// IsPut returns true iff the operation is a Put.
+func (op Op) IsPut() bool { return op.t == tPut }
+
+// IsDelete returns true iff the operation is a Delete.
+func (op Op) IsDelete() bool { return op.t == tDeleteRange }
+
+// KeyBytes returns the byte slice holding the Op's key.
+func (op Op) KeyBytes() []byte { return op.key }
+
+// WithKeyBytes sets the byte slice for the Op's key.
+func (op *Op) WithKeyBytes(key []byte) { op.key = key }
+
+// RangeBytes returns the byte slice holding the Op's range end, if any.
+func (op Op) RangeBytes() []byte { return op.end }
+
+// WithRangeBytes sets the byte slice for the Op's range end.
+func (op *Op) WithRangeBytes(end []byte) { op.end = end }
+
+// Clone returns a copy of the Op that shares no key, range end, or value
+// bytes with the original, so either may be modified independently.
+func (op Op) Clone() Op {
+	op.key = cloneBytes(op.key)
+	op.end = cloneBytes(op.end)
+	op.val = cloneBytes(op.val)
+	return op
+}
+
+func cloneBytes(b []byte) []byte {
+	if b == nil {
+		return nil
+	}
+	return append([]byte{}, b...)
 }
 
+func (op Op) toRangeRequest() *pb.RangeRequest {
+	if op.t != tRange {
+		panic("op.t != tRange")
+	}
+	r := &pb.RangeRequest{
+		Key:          op.key,
+		RangeEnd:     op.end,
+		Limit:        op.limit,
+		Revision:     op.rev,
+		Serializable: op.serializable,
+		CountOnly:    op.countOnly,
+		KeysOnly:     op.keysOnly,
+
+		MinCreateRevision: op.minCreateRev,
+		MaxCreateRevision: op.maxCreateRev,
+		MinModRevision:    op.minModRev,
+		MaxModRevision:    op.maxModRev,
+	}
+	// sorting a single key is meaningless; drop it
+	if op.sort != nil && len(op.end) != 0 {
+		r.SortOrder = pb.RangeRequest_SortOrder(op.sort.Order)
+		r.SortTarget = pb.RangeRequest_SortTarget(op.sort.Target)
+	}
+	return r
+}
+
+func (op Op) toPutRequest() *pb.PutRequest {
+	if op.t != tPut {
+		panic("op.t != tPut")
+	}
+	return &pb.PutRequest{Key: op.key, Value: op.val, Lease: int64(op.leaseID), IgnoreValue: op.ignoreValue, IgnoreLease: op.ignoreLease, PrevKv: op.prevKV}
+}
+
+func (op Op) toDeleteRangeRequest() *pb.DeleteRangeRequest {
+	if op.t != tDeleteRange {
+		panic("op.t != tDeleteRange")
+	}
+	return &pb.DeleteRangeRequest{Key: op.key, RangeEnd: op.end, PrevKv: op.prevKV}
+}
+
+// toRequestUnion wraps the request of op for embedding in a transaction.
 func (op Op) toRequestUnion() *pb.RequestUnion {
 	switch op.t {
 	case tRange:
-		r := &pb.RangeRequest{Key: op.key, RangeEnd: op.end, Limit: op.limit, Revision: op.rev, Serializable: op.serializable}
-		if op.sort != nil {
-			r.SortOrder = pb.RangeRequest_SortOrder(op.sort.Order)
-			r.SortTarget = pb.RangeRequest_SortTarget(op.sort.Target)
-		}
-		return &pb.RequestUnion{Request: &pb.RequestUnion_RequestRange{RequestRange: r}}
+		return &pb.RequestUnion{Request: &pb.RequestUnion_RequestRange{RequestRange: op.toRangeRequest()}}
 	case tPut:
-		r := &pb.PutRequest{Key: op.key, Value: op.val, Lease: int64(op.leaseID)}
-		return &pb.RequestUnion{Request: &pb.RequestUnion_RequestPut{RequestPut: r}}
+		return &pb.RequestUnion{Request: &pb.RequestUnion_RequestPut{RequestPut: op.toPutRequest()}}
 	case tDeleteRange:
-		r := &pb.DeleteRangeRequest{Key: op.key, RangeEnd: op.end}
-		return &pb.RequestUnion{Request: &pb.RequestUnion_RequestDeleteRange{RequestDeleteRange: r}}
+		return &pb.RequestUnion{Request: &pb.RequestUnion_RequestDeleteRange{RequestDeleteRange: op.toDeleteRangeRequest()}}
 	default:
 		panic("Unknown Op")
 	}
@@ -80,15 +176,37 @@ func (op Op) isWrite() bool {
 func OpGet(key string, opts ...OpOption) Op {
 	ret := Op{t: tRange, key: []byte(key)}
 	ret.applyOpts(opts)
+	ret.resolveEmptyKey()
+	switch {
+	case ret.leaseID != 0:
+		panic("unexpected lease in get")
+	case ret.ignoreValue:
+		panic("unexpected ignoreValue in get")
+	case ret.ignoreLease:
+		panic("unexpected ignoreLease in get")
+	case ret.prevKV:
+		panic("unexpected prevKV in get")
+	case ret.filterPut, ret.filterDelete:
+		panic("unexpected filter in get")
+	case ret.batchEvents:
+		panic("unexpected batchEvents in get")
+	case ret.snapshotRecovery:
+		panic("unexpected snapshotRecovery in get")
+	}
 	return ret
 }
 
 func OpDelete(key string, opts ...OpOption) Op {
 	ret := Op{t: tDeleteRange, key: []byte(key)}
 	ret.applyOpts(opts)
+	ret.resolveEmptyKey()
 	switch {
 	case ret.leaseID != 0:
 		panic("unexpected lease in delete")
+	case ret.ignoreValue:
+		panic("unexpected ignoreValue in delete")
+	case ret.ignoreLease:
+		panic("unexpected ignoreLease in delete")
 	case ret.limit != 0:
 		panic("unexpected limit in delete")
 	case ret.rev != 0:
@@ -97,6 +215,20 @@ func OpDelete(key string, opts ...OpOption) Op {
 		panic("unexpected sort in delete")
 	case ret.serializable:
 		panic("unexpected serializable in delete")
+	case ret.countOnly:
+		panic("unexpected countOnly in delete")
+	case ret.keysOnly:
+		panic("unexpected keysOnly in delete")
+	case ret.minCreateRev != 0, ret.maxCreateRev != 0:
+		panic("unexpected createRev in delete")
+	case ret.minModRev != 0, ret.maxModRev != 0:
+		panic("unexpected modRev in delete")
+	case ret.filterPut, ret.filterDelete:
+		panic("unexpected filter in delete")
+	case ret.batchEvents:
+		panic("unexpected batchEvents in delete")
+	case ret.snapshotRecovery:
+		panic("unexpected snapshotRecovery in delete")
 	}
 	return ret
 }
@@ -115,6 +247,22 @@ func OpPut(key, val string, opts ...OpOption) Op {
 		panic("unexpected sort in put")
 	case ret.serializable:
 		panic("unexpected serializable in put")
+	case ret.countOnly:
+		panic("unexpected countOnly in put")
+	case ret.keysOnly:
+		panic("unexpected keysOnly in put")
+	case ret.minCreateRev != 0, ret.maxCreateRev != 0:
+		panic("unexpected createRev in put")
+	case ret.minModRev != 0, ret.maxModRev != 0:
+		panic("unexpected modRev in put")
+	case ret.ignoreValue && ret.ignoreLease:
+		panic("unexpected ignoreValue with ignoreLease in put")
+	case ret.filterPut, ret.filterDelete:
+		panic("unexpected filter in put")
+	case ret.batchEvents:
+		panic("unexpected batchEvents in put")
+	case ret.snapshotRecovery:
+		panic("unexpected snapshotRecovery in put")
 	}
 	return ret
 }
@@ -122,19 +270,43 @@ func OpPut(key, val string, opts ...OpOption) Op {
 func opWatch(key string, opts ...OpOption) Op {
 	ret := Op{t: tRange, key: []byte(key)}
 	ret.applyOpts(opts)
+	ret.resolveEmptyKey()
 	switch {
 	case ret.leaseID != 0:
 		panic("unexpected lease in watch")
+	case ret.ignoreValue:
+		panic("unexpected ignoreValue in watch")
+	case ret.ignoreLease:
+		panic("unexpected ignoreLease in watch")
 	case ret.limit != 0:
 		panic("unexpected limit in watch")
 	case ret.sort != nil:
 		panic("unexpected sort in watch")
 	case ret.serializable:
 		panic("unexpected serializable in watch")
+	case ret.countOnly:
+		panic("unexpected countOnly in watch")
+	case ret.keysOnly:
+		panic("unexpected keysOnly in watch")
+	case ret.minCreateRev != 0, ret.maxCreateRev != 0:
+		panic("unexpected createRev in watch")
+	case ret.minModRev != 0, ret.maxModRev != 0:
+		panic("unexpected modRev in watch")
+	case ret.filterPut && ret.filterDelete:
+		panic("unexpected filterPut with filterDelete in watch")
 	}
 	return ret
 }
 
+// resolveEmptyKey starts a range with an empty key at the first key of the
+// keyspace. It is applied once all options are, so the range end given by
+// the last of WithPrefix, WithFromKey, and WithRange takes effect.
+func (op *Op) resolveEmptyKey() {
+	if len(op.key) == 0 && len(op.end) > 0 {
+		op.key = []byte{0}
+	}
+}
+
 func (op *Op) applyOpts(opts []OpOption) {
 	for _, opt := range opts {
 		opt(op)
@@ -144,20 +316,68 @@ func (op *Op) applyOpts(opts []OpOption) {
 // OpOption configures Operations like Get, Put, Delete.
 type OpOption func(*Op)
 
-// WithLease attaches a lease ID to a key in 'Put' request.
+// WithLease attaches a lease ID to a key in 'Put' request. The key is
+// deleted when the lease expires or is revoked. Leases only apply to puts;
+// using WithLease with any other operation panics.
 func WithLease(leaseID LeaseID) OpOption {
 	return func(op *Op) { op.leaseID = leaseID }
 }
 
+// WithPrevKV returns the key-values as they were before a 'Put' or 'Delete'
+// request changed them. A put returns the overwritten key-value in
+// PutResponse.PrevKv, which is nil if the key did not exist; a delete
+// returns all deleted key-values in DeleteResponse.PrevKvs. A 'Watch'
+// sets the Event.PrevKv of each event, unless the previous revision is
+// compacted.
+func WithPrevKV() OpOption {
+	return func(op *Op) { op.prevKV = true }
+}
+
+// WithIgnoreValue updates the key using its current value, so a 'Put'
+// request can change the lease of a key without rewriting its value. The
+// value given to the put must be empty, and the put fails if the key does
+// not exist.
+func WithIgnoreValue() OpOption {
+	return func(op *Op) { op.ignoreValue = true }
+}
+
+// WithIgnoreLease updates the key using its current lease, so a 'Put'
+// request can change the value of a key without detaching it from its
+// lease. Any lease given by WithLease is ignored, and the put fails if the
+// key does not exist. It cannot be combined with WithIgnoreValue.
+func WithIgnoreLease() OpOption {
+	return func(op *Op) { op.ignoreLease = true }
+}
+
 // WithLimit limits the number of results to return from 'Get' request.
+// Combined with WithFromKey and WithSortByKey, it pages over the keyspace:
+// the next page starts at the last returned key + "\x00", until the
+// response's More flag is false.
 func WithLimit(n int64) OpOption { return func(op *Op) { op.limit = n } }
 
 // WithRev specifies the store revision for 'Get' request.
 // Or the start revision of 'Watch' request.
 func WithRev(rev int64) OpOption { return func(op *Op) { op.rev = rev } }
 
+// WithMinCreateRev filters out keys for 'Get' with creation revisions less than the given revision.
+// The filtering is done by the server, so the filtered keys are never sent to the client.
+func WithMinCreateRev(rev int64) OpOption { return func(op *Op) { op.minCreateRev = rev } }
+
+// WithMaxCreateRev filters out keys for 'Get' with creation revisions greater than the given revision.
+// The filtering is done by the server, so the filtered keys are never sent to the client.
+func WithMaxCreateRev(rev int64) OpOption { return func(op *Op) { op.maxCreateRev = rev } }
+
+// WithMinModRev filters out keys for 'Get' with modification revisions less than the given revision.
+// The filtering is done by the server, so the filtered keys are never sent to the client.
+func WithMinModRev(rev int64) OpOption { return func(op *Op) { op.minModRev = rev } }
+
+// WithMaxModRev filters out keys for 'Get' with modification revisions greater than the given revision.
+// The filtering is done by the server, so the filtered keys are never sent to the client.
+func WithMaxModRev(rev int64) OpOption { return func(op *Op) { op.maxModRev = rev } }
+
 // WithSort specifies the ordering in 'Get' request. It requires
-// 'WithRange' and/or 'WithPrefix' to be specified too.
+// 'WithRange' and/or 'WithPrefix' to be specified too; it is ignored
+// on a single key 'Get'.
 // 'target' specifies the target to sort by: key, version, revisions, value.
 // 'order' can be either 'SortNone', 'SortAscend', 'SortDescend'.
 func WithSort(target SortTarget, order SortOrder) OpOption {
@@ -166,6 +386,26 @@ func WithSort(target SortTarget, order SortOrder) OpOption {
 	}
 }
 
+// WithSortByKey sorts the keys of a ranged 'Get' request by key in the
+// given order. It has no effect on a single key 'Get'.
+func WithSortByKey(order SortOrder) OpOption { return WithSort(SortByKey, order) }
+
+// WithSortByModRevision sorts the keys of a ranged 'Get' request by
+// modification revision in the given order. It has no effect on a
+// single key 'Get'.
+func WithSortByModRevision(order SortOrder) OpOption { return WithSort(SortByModRevision, order) }
+
+// WithSortByCreateRevision sorts the keys of a ranged 'Get' request by
+// creation revision in the given order. It has no effect on a single
+// key 'Get'.
+func WithSortByCreateRevision(order SortOrder) OpOption {
+	return WithSort(SortByCreateRevision, order)
+}
+
+// WithSortByVersion sorts the keys of a ranged 'Get' request by version
+// in the given order. It has no effect on a single key 'Get'.
+func WithSortByVersion(order SortOrder) OpOption { return WithSort(SortByVersion, order) }
+
 func getPrefix(key []byte) []byte {
 	end := make([]byte, len(key))
 	copy(end, key)
@@ -181,13 +421,21 @@ func getPrefix(key []byte) []byte {
 	return noPrefixEnd
 }
 
+// GetPrefixRangeEnd gets the range end of the prefix.
+// 'Get(foo, WithPrefix())' is equal to 'Get(foo, WithRange(GetPrefixRangeEnd(foo))'.
+// The end is the prefix with its last byte below 0xff incremented and the
+// bytes after it dropped. A prefix with no such byte, such as "" or
+// "\xff\xff", has no successor, so "\x00" is returned to range to the end
+// of the keyspace.
+func GetPrefixRangeEnd(prefix string) string {
+	return string(getPrefix([]byte(prefix)))
+}
+
 // WithPrefix enables 'Get', 'Delete', or 'Watch' requests to operate
 // on the keys with matching prefix. For example, 'Get(foo, WithPrefix())'
-// can return 'foo1', 'foo2', and so on.
+// can return 'foo1', 'foo2', and so on. An empty prefix matches all keys.
 func WithPrefix() OpOption {
-	return func(op *Op) {
-		op.end = getPrefix(op.key)
-	}
+	return func(op *Op) { op.end = getPrefix(op.key) }
 }
 
 // WithRange specifies the range of 'Get' or 'Delete' requests.
@@ -198,16 +446,45 @@ func WithRange(endKey string) OpOption {
 }
 
 // WithFromKey specifies the range of 'Get' or 'Delete' requests
-// to be equal or greater than the key in the argument.
-func WithFromKey() OpOption { return WithRange("\x00") }
+// to be equal or greater than the key in the argument. An empty key
+// ranges over the entire keyspace. WithFromKey, WithPrefix, and
+// WithRange all set the range end, so the last one given takes effect.
+// Combined with WithLimit, the keyspace can be paged by issuing the next
+// request from the last returned key followed by "\x00".
+func WithFromKey() OpOption {
+	return func(op *Op) { op.end = []byte("\x00") }
+}
 
 // WithSerializable makes 'Get' request serializable. By default,
 // it's linearizable. Serializable requests are better for lower latency
 // requirement.
+//
+// A serializable request is served from the local state of whichever
+// member receives it, without going through raft, so the returned data
+// may be stale: it can miss writes that were already committed by the
+// cluster but not yet applied on that member. Since any member can serve
+// the request, a serializable 'Get' that fails because of the member it
+// was sent to is retried on another endpoint.
+//
+// Config.MaxStaleness bounds the staleness relative to the revisions the
+// client has seen.
 func WithSerializable() OpOption {
 	return func(op *Op) { op.serializable = true }
 }
 
+// WithCountOnly makes the 'Get' request return only the count of keys.
+// The response has no key-value pairs; the number of keys in the
+// range is reported in its Count field.
+func WithCountOnly() OpOption {
+	return func(op *Op) { op.countOnly = true }
+}
+
+// WithKeysOnly makes the 'Get' request return only the keys; the
+// values of the returned key-value pairs are left empty.
+func WithKeysOnly() OpOption {
+	return func(op *Op) { op.keysOnly = true }
+}
+
 // WithFirstCreate gets the key with the oldest creation revision in the request range.
 func WithFirstCreate() []OpOption { return withTop(SortByCreateRevision, SortAscend) }
 
@@ -232,9 +509,59 @@ func withTop(target SortTarget, order SortOrder) []OpOption {
 }
 
 // WithProgressNotify makes watch server send periodic progress updates.
-// Progress updates have zero events in WatchResponse.
+// Progress updates have zero events in WatchResponse; their header revision
+// is the current store revision, so a quiet watcher can use it to confirm
+// the watch is alive and to advance the revision it would resume from.
 func WithProgressNotify() OpOption {
 	return func(op *Op) {
 		op.progressNotify = true
 	}
 }
+
+// WithBatchEvents makes the watcher coalesce events into fewer responses.
+// Events arriving within maxDelay of the first event of a batch are
+// delivered in a single WatchResponse, in order and with the header of the
+// last response merged, holding at most maxCount events; a non-positive
+// maxCount does not bound the batch. Responses without events, such as
+// progress notifications, end the batch. Events buffered while the
+// subscriber is slow are coalesced as well, and are still delivered when
+// the watch is closed.
+func WithBatchEvents(maxDelay time.Duration, maxCount int) OpOption {
+	return func(op *Op) {
+		op.batchEvents = true
+		op.batchDelay = maxDelay
+		op.batchCount = maxCount
+	}
+}
+
+// WithSnapshotRecovery makes the watcher recover from a compacted revision
+// instead of ending the watch with ErrCompacted. It gets the watched keys at
+// the current revision, delivers them as PUT events in a response with
+// Snapshot set, and resumes watching from the revision following the Get.
+// The snapshot replaces the state the subscriber built from earlier events;
+// keys deleted while the watch was behind are absent from it rather than
+// reported as DELETE events. The watch still ends with the error of the Get
+// if it fails.
+func WithSnapshotRecovery() OpOption {
+	return func(op *Op) { op.snapshotRecovery = true }
+}
+
+// WithFilterPut discards PUT events from the watcher.
+func WithFilterPut() OpOption {
+	return func(op *Op) { op.filterPut = true }
+}
+
+// WithFilterDelete discards DELETE events from the watcher.
+func WithFilterDelete() OpOption {
+	return func(op *Op) { op.filterDelete = true }
+}
+
+// WithLeaderRequired is the OpOption form of WithRequireLeader. A request
+// rejected by a member without a leader is issued again on the other
+// members, each tried once, and fails with ErrNoLeader if none has a
+// leader. Watches with it share a stream that requires a leader; they are
+// closed with ErrNoLeader when the member loses its leader. It has no
+// effect on the ops of a txn; pass WithRequireLeader to Txn instead.
+func WithLeaderRequired() OpOption {
+	return func(op *Op) { op.requireLeader = true }
+}
@@ -0,0 +1,273 @@
+// Copyright 2016 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clientv3
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	pb "github.com/coreos/etcd/etcdserver/etcdserverpb"
+)
+
+func TestWithPrefix(t *testing.T) {
+	tests := []struct {
+		key string
+
+		wkey []byte
+		wend []byte
+	}{
+		{"foo", []byte("foo"), []byte("fop")},
+		{"foo/", []byte("foo/"), []byte("foo0")},
+		{"a\xff", []byte("a\xff"), []byte("b")},
+		{"a\xff\xff", []byte("a\xff\xff"), []byte("b")},
+		// no successor; range to the end of the keyspace
+		{"\xff", []byte("\xff"), noPrefixEnd},
+		{"\xff\xff", []byte("\xff\xff"), noPrefixEnd},
+		// empty prefix matches all keys
+		{"", []byte{0}, []byte{0}},
+	}
+	for i, tt := range tests {
+		op := OpGet(tt.key, WithPrefix())
+		if !bytes.Equal(op.key, tt.wkey) {
+			t.Errorf("#%d: key = %q, want %q", i, op.key, tt.wkey)
+		}
+		if !bytes.Equal(op.end, tt.wend) {
+			t.Errorf("#%d: end = %q, want %q", i, op.end, tt.wend)
+		}
+	}
+}
+
+func TestGetPrefixRangeEnd(t *testing.T) {
+	tests := []struct {
+		prefix string
+		wend   string
+	}{
+		{"foo", "fop"},
+		{"a", "b"},
+		// empty prefix ranges over the entire keyspace
+		{"", "\x00"},
+		// trailing 0xff bytes are dropped
+		{"a\xff", "b"},
+		{"foo\xff\xff", "fop"},
+		// rollover over several bytes stops at the first byte below 0xff
+		{"\x01\xfe\xff\xff", "\x01\xff"},
+		{"\x00\xff", "\x01"},
+		// no successor; range to the end of the keyspace
+		{"\xff", "\x00"},
+		{"\xff\xff\xff", "\x00"},
+	}
+	for i, tt := range tests {
+		if end := GetPrefixRangeEnd(tt.prefix); end != tt.wend {
+			t.Errorf("#%d: GetPrefixRangeEnd(%q) = %q, want %q", i, tt.prefix, end, tt.wend)
+		}
+	}
+}
+
+func TestWithPrefixLimitSort(t *testing.T) {
+	op := OpGet("foo/", WithPrefix(), WithLimit(10), WithSort(SortByKey, SortDescend))
+	if !bytes.Equal(op.end, []byte("foo0")) {
+		t.Errorf("end = %q, want %q", op.end, "foo0")
+	}
+	if op.limit != 10 {
+		t.Errorf("limit = %d, want 10", op.limit)
+	}
+	if op.sort == nil || op.sort.Target != SortByKey || op.sort.Order != SortDescend {
+		t.Errorf("sort = %+v, want %+v", op.sort, SortOption{SortByKey, SortDescend})
+	}
+
+	dop := OpDelete("foo/", WithPrefix())
+	if !bytes.Equal(dop.end, []byte("foo0")) {
+		t.Errorf("delete end = %q, want %q", dop.end, "foo0")
+	}
+}
+
+func TestWithSortBy(t *testing.T) {
+	tests := []struct {
+		opt OpOption
+
+		wtarget pb.RangeRequest_SortTarget
+	}{
+		{WithSortByKey(SortDescend), pb.RangeRequest_KEY},
+		{WithSortByModRevision(SortDescend), pb.RangeRequest_MOD},
+		{WithSortByCreateRevision(SortDescend), pb.RangeRequest_CREATE},
+		{WithSortByVersion(SortDescend), pb.RangeRequest_VERSION},
+	}
+	for i, tt := range tests {
+		r := OpGet("foo", WithPrefix(), tt.opt).toRangeRequest()
+		if r.SortTarget != tt.wtarget || r.SortOrder != pb.RangeRequest_DESCEND {
+			t.Errorf("#%d: sort = %v/%v, want %v/%v", i, r.SortTarget, r.SortOrder, tt.wtarget, pb.RangeRequest_DESCEND)
+		}
+		// sorting a single key is a no-op
+		r = OpGet("foo", tt.opt).toRangeRequest()
+		if r.SortTarget != pb.RangeRequest_KEY || r.SortOrder != pb.RangeRequest_NONE {
+			t.Errorf("#%d: single key sort = %v/%v, want none", i, r.SortTarget, r.SortOrder)
+		}
+	}
+}
+
+func TestWithFromKey(t *testing.T) {
+	tests := []struct {
+		key  string
+		opts []OpOption
+
+		wkey []byte
+		wend []byte
+	}{
+		{"foo", []OpOption{WithFromKey()}, []byte("foo"), []byte{0}},
+		{"", []OpOption{WithFromKey()}, []byte{0}, []byte{0}},
+		// the last range option wins
+		{"foo", []OpOption{WithPrefix(), WithFromKey()}, []byte("foo"), []byte{0}},
+		{"foo", []OpOption{WithFromKey(), WithPrefix()}, []byte("foo"), []byte("fop")},
+		{"foo", []OpOption{WithFromKey(), WithRange("goo")}, []byte("foo"), []byte("goo")},
+		// an empty key ranges over the entire keyspace whatever the order
+		{"", []OpOption{WithFromKey(), WithPrefix()}, []byte{0}, []byte{0}},
+		{"", []OpOption{WithPrefix(), WithFromKey()}, []byte{0}, []byte{0}},
+		{"", []OpOption{WithPrefix(), WithRange("goo")}, []byte{0}, []byte("goo")},
+	}
+	for i, tt := range tests {
+		op := OpGet(tt.key, tt.opts...)
+		if !bytes.Equal(op.key, tt.wkey) {
+			t.Errorf("#%d: key = %q, want %q", i, op.key, tt.wkey)
+		}
+		if !bytes.Equal(op.end, tt.wend) {
+			t.Errorf("#%d: end = %q, want %q", i, op.end, tt.wend)
+		}
+	}
+}
+
+func TestOpToRequestUnion(t *testing.T) {
+	tests := []struct {
+		op Op
+
+		w *pb.RequestUnion
+	}{
+		{
+			OpGet("foo", WithPrefix(), WithRev(3), WithKeysOnly()),
+			&pb.RequestUnion{Request: &pb.RequestUnion_RequestRange{
+				RequestRange: &pb.RangeRequest{Key: []byte("foo"), RangeEnd: []byte("fop"), Revision: 3, KeysOnly: true},
+			}},
+		},
+		{
+			OpPut("foo", "bar", WithLease(LeaseID(5))),
+			&pb.RequestUnion{Request: &pb.RequestUnion_RequestPut{
+				RequestPut: &pb.PutRequest{Key: []byte("foo"), Value: []byte("bar"), Lease: 5},
+			}},
+		},
+		{
+			OpDelete("foo", WithRange("goo")),
+			&pb.RequestUnion{Request: &pb.RequestUnion_RequestDeleteRange{
+				RequestDeleteRange: &pb.DeleteRangeRequest{Key: []byte("foo"), RangeEnd: []byte("goo")},
+			}},
+		},
+	}
+	for i, tt := range tests {
+		if g := tt.op.toRequestUnion(); !reflect.DeepEqual(g, tt.w) {
+			t.Errorf("#%d: request union = %+v, want %+v", i, g, tt.w)
+		}
+	}
+}
+
+func TestWithLeasePanics(t *testing.T) {
+	tests := []func(){
+		func() { OpGet("foo", WithLease(LeaseID(1))) },
+		func() { OpDelete("foo", WithLease(LeaseID(1))) },
+		func() { opWatch("foo", WithLease(LeaseID(1))) },
+	}
+	for i, f := range tests {
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("#%d: expected panic on lease", i)
+				}
+			}()
+			f()
+		}()
+	}
+
+	if op := OpPut("foo", "bar", WithLease(LeaseID(1))); op.leaseID != 1 {
+		t.Errorf("leaseID = %d, want 1", op.leaseID)
+	}
+}
+
+func TestWithIgnorePanics(t *testing.T) {
+	tests := []func(){
+		func() { OpGet("foo", WithIgnoreValue()) },
+		func() { OpDelete("foo", WithIgnoreLease()) },
+		func() { opWatch("foo", WithIgnoreValue()) },
+		func() { OpPut("foo", "", WithIgnoreValue(), WithIgnoreLease()) },
+	}
+	for i, f := range tests {
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("#%d: expected panic", i)
+				}
+			}()
+			f()
+		}()
+	}
+
+	req := OpPut("foo", "bar", WithIgnoreLease()).toPutRequest()
+	if !req.IgnoreLease || req.IgnoreValue {
+		t.Errorf("put request = %+v, want only IgnoreLease set", req)
+	}
+}
+
+func TestWithFilterPanics(t *testing.T) {
+	tests := []func(){
+		func() { OpGet("foo", WithFilterPut()) },
+		func() { OpPut("foo", "bar", WithFilterDelete()) },
+		func() { OpDelete("foo", WithFilterPut()) },
+		func() { opWatch("foo", WithFilterPut(), WithFilterDelete()) },
+	}
+	for i, f := range tests {
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("#%d: expected panic", i)
+				}
+			}()
+			f()
+		}()
+	}
+
+	if op := opWatch("foo", WithFilterDelete()); op.filterPut || !op.filterDelete {
+		t.Errorf("op = %+v, want only filterDelete set", op)
+	}
+}
+
+func TestOpClone(t *testing.T) {
+	op := OpPut("foo", "bar")
+	cop := op.Clone()
+	if !reflect.DeepEqual(op, cop) {
+		t.Fatalf("clone = %+v, want %+v", cop, op)
+	}
+	op.KeyBytes()[0] = 'g'
+	op.val[0] = 'c'
+	if string(cop.KeyBytes()) != "foo" || string(cop.val) != "bar" {
+		t.Errorf("clone changed with original to %q=%q", cop.KeyBytes(), cop.val)
+	}
+
+	gop := OpGet("foo", WithPrefix())
+	cop = gop.Clone()
+	gop.RangeBytes()[0] = 'x'
+	if string(cop.RangeBytes()) != "fop" {
+		t.Errorf("range end = %q, want %q", cop.RangeBytes(), "fop")
+	}
+	if cop = OpGet("foo").Clone(); cop.RangeBytes() != nil {
+		t.Errorf("range end = %q, want nil", cop.RangeBytes())
+	}
+}
@@ -16,6 +16,7 @@ package clientv3
 
 import (
 	"sync"
+	"time"
 
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
@@ -26,6 +27,10 @@ type remoteClient struct {
 	conn       *grpc.ClientConn
 	updateConn func(*grpc.ClientConn)
 	mu         sync.Mutex
+
+	// fixed is set if the remote was given in place of a connection, so
+	// there is nothing to reconnect
+	fixed bool
 }
 
 func newRemoteClient(client *Client, update func(*grpc.ClientConn)) *remoteClient {
@@ -40,36 +45,80 @@ func newRemoteClient(client *Client, update func(*grpc.ClientConn)) *remoteClien
 	return ret
 }
 
+// newFixedRemoteClient returns a remote client for a remote given by the
+// caller rather than built on the client's connection.
+func newFixedRemoteClient(client *Client) *remoteClient {
+	return &remoteClient{
+		client:     client,
+		updateConn: func(*grpc.ClientConn) {},
+		fixed:      true,
+	}
+}
+
 // reconnectWait reconnects the client, returning when connection establishes/fails.
 func (r *remoteClient) reconnectWait(ctx context.Context, prevErr error) error {
-	r.mu.Lock()
-	updated := r.tryUpdate()
-	r.mu.Unlock()
-	if updated {
+	return r.reconnectWaitFrom(ctx, nil, prevErr)
+}
+
+// reconnectWaitFrom is reconnectWait for a request that failed on the
+// connection failed. Requests failing on the same connection share a single
+// reconnect; a request failing after it completed reuses the new connection.
+func (r *remoteClient) reconnectWaitFrom(ctx context.Context, failed *grpc.ClientConn, prevErr error) error {
+	if r.fixed {
+		// no connection to replace; pace the retries as reconnects are
+		select {
+		case <-time.After(minConnRetryWait):
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	if r.tryUpdate() || r.movedFrom(failed) {
 		return nil
 	}
-	conn, err := r.client.connWait(ctx, prevErr)
-	if err == nil {
-		r.mu.Lock()
-		r.conn = conn
-		r.updateConn(conn)
-		r.mu.Unlock()
+	if _, err := r.client.connWait(ctx, failed, prevErr); err != nil {
+		return err
 	}
-	return err
+	r.tryUpdate()
+	return nil
 }
 
 // reconnect will reconnect the client without waiting
 func (r *remoteClient) reconnect(err error) {
-	r.mu.Lock()
-	defer r.mu.Unlock()
-	if r.tryUpdate() {
+	r.reconnectFrom(nil, err)
+}
+
+// reconnectFrom is reconnect for a request that failed on the connection
+// failed; it does nothing if the client already moved off that connection.
+func (r *remoteClient) reconnectFrom(failed *grpc.ClientConn, err error) {
+	if r.fixed {
+		return
+	}
+	if r.tryUpdate() || r.movedFrom(failed) {
 		return
 	}
 	r.client.connStartRetry(err)
 }
 
+// movedFrom returns true if the remote no longer uses the connection failed.
+func (r *remoteClient) movedFrom(failed *grpc.ClientConn) bool {
+	if failed == nil {
+		return false
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.conn != failed
+}
+
+// tryUpdate switches the remote to the client's active connection. It takes
+// the client lock before its own, the same order as acquire, and only
+// updates while no request holds the remote's current connection.
 func (r *remoteClient) tryUpdate() bool {
-	activeConn := r.client.ActiveConnection()
+	r.client.mu.RLock()
+	defer r.client.mu.RUnlock()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	activeConn := r.client.conn
 	if activeConn == nil || activeConn == r.conn {
 		return false
 	}
@@ -78,19 +127,30 @@ func (r *remoteClient) tryUpdate() bool {
 	return true
 }
 
-func (r *remoteClient) acquire(ctx context.Context) error {
+// acquire waits until the remote uses the client's active connection and
+// returns it. The connection stays in place until release is called. It
+// fails with ErrClientClosed once the client is closed. A fixed remote has
+// no connection, so acquire returns nil for it.
+func (r *remoteClient) acquire(ctx context.Context) (*grpc.ClientConn, error) {
 	for {
 		r.client.mu.RLock()
+		if r.client.cancel == nil {
+			r.client.mu.RUnlock()
+			return nil, ErrClientClosed
+		}
+		if r.fixed {
+			return nil, nil
+		}
 		c := r.client.conn
 		r.mu.Lock()
-		match := r.conn == c
+		match := c != nil && r.conn == c
 		r.mu.Unlock()
 		if match {
-			return nil
+			return c, nil
 		}
 		r.client.mu.RUnlock()
 		if err := r.reconnectWait(ctx, nil); err != nil {
-			return err
+			return nil, err
 		}
 	}
 }
@@ -0,0 +1,306 @@
+// Copyright 2016 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clientv3
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/coreos/etcd/etcdserver/api/v3rpc/rpctypes"
+	pb "github.com/coreos/etcd/etcdserver/etcdserverpb"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// RetryPolicy controls how requests that failed on a broken connection
+// are retried after switching to a new connection.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of times a request is issued,
+	// including the first attempt. Zero means no limit.
+	MaxAttempts int
+
+	// InitialBackoff is the wait before the first retry. Zero disables
+	// backoff between retries.
+	InitialBackoff time.Duration
+
+	// MaxBackoff caps the wait between retries. Zero means no cap.
+	MaxBackoff time.Duration
+
+	// Multiplier scales the backoff after each retry. Values less than
+	// 1 are treated as 1.
+	Multiplier float64
+
+	// Jitter randomizes each backoff by up to the given fraction of it,
+	// in both directions (e.g., 0.2 gives a backoff in [0.8b, 1.2b]).
+	Jitter float64
+
+	// FullJitter picks each backoff uniformly from [0, b), so clients
+	// that fail together spread out their retries. It overrides Jitter.
+	FullJitter bool
+}
+
+// RetryError is returned when a request still fails after all attempts
+// allowed by the RetryPolicy.
+type RetryError struct {
+	// Attempts is the number of times the request was issued.
+	Attempts int
+	// Err is the error of the last attempt.
+	Err error
+}
+
+func (e *RetryError) Error() string {
+	return fmt.Sprintf("etcdclient: request failed after %d attempts (%v)", e.Attempts, e.Err)
+}
+
+// backoff returns the wait before retrying a request that failed on the
+// given attempt, starting from 1.
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	if p.InitialBackoff <= 0 {
+		return 0
+	}
+	mult := p.Multiplier
+	if mult < 1 {
+		mult = 1
+	}
+	b := float64(p.InitialBackoff)
+	for i := 1; i < attempt; i++ {
+		b *= mult
+		if p.MaxBackoff > 0 && b >= float64(p.MaxBackoff) {
+			break
+		}
+	}
+	if p.MaxBackoff > 0 && b > float64(p.MaxBackoff) {
+		b = float64(p.MaxBackoff)
+	}
+	switch {
+	case p.FullJitter:
+		b *= rand.Float64()
+	case p.Jitter > 0:
+		b += b * p.Jitter * (2*rand.Float64() - 1)
+	}
+	return time.Duration(b)
+}
+
+// retryWait waits out the backoff after a failed attempt according to the
+// client's RetryPolicy. It returns a RetryError once the attempts are
+// exhausted, or the context error if ctx is done before the backoff ends.
+// If the client's retry budget is exhausted, it returns the attempt's
+// error so the request fails without retrying.
+func (c *Client) retryWait(ctx context.Context, attempt int, err error) error {
+	if !c.retries.withdraw() {
+		return rpctypes.Error(err)
+	}
+	p := c.cfg.RetryPolicy
+	if p == nil {
+		return nil
+	}
+	if p.MaxAttempts > 0 && attempt >= p.MaxAttempts {
+		return &RetryError{Attempts: attempt, Err: rpctypes.Error(err)}
+	}
+	d := p.backoff(attempt)
+	if d <= 0 {
+		return nil
+	}
+	select {
+	case <-time.After(d):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// retryRPC calls f until it succeeds, fails with an error that may not be
+// retried, or the RetryPolicy gives up. Only errors returned by a member
+// that is still reachable are retried here; broken connections are left to
+// the callers, which reconnect. Writes are only retried if the error
+// guarantees the request was not applied.
+func (c *Client) retryRPC(ctx context.Context, isWrite bool, f func() error) error {
+	for attempt := 1; ; attempt++ {
+		err := f()
+		if err == nil {
+			return nil
+		}
+		if isHaltErr(ctx, err) || !isServerErr(err) || grpc.Code(err) == codes.Unauthenticated {
+			// an invalid auth token needs a new connection
+			return err
+		}
+		if isWrite && !isNotAppliedErr(err) {
+			return err
+		}
+		if werr := c.retryWait(ctx, attempt, err); werr != nil {
+			return werr
+		}
+	}
+}
+
+// isServerErr returns true if err was returned by the etcd server rather
+// than by the connection to it.
+func isServerErr(err error) bool {
+	return strings.HasPrefix(grpc.ErrorDesc(err), "etcdserver: ")
+}
+
+// isNotAppliedErr returns true if err means the server rejected the request
+// before proposing it, so a write is safe to issue again.
+func isNotAppliedErr(err error) bool {
+	desc := grpc.ErrorDesc(err)
+	return desc == grpc.ErrorDesc(rpctypes.ErrGRPCNoLeader) ||
+		desc == grpc.ErrorDesc(rpctypes.ErrGRPCNotCapable)
+}
+
+// isNoLeaderErr returns true if err means the member had no leader, as
+// during an election.
+func isNoLeaderErr(err error) bool {
+	return grpc.ErrorDesc(err) == grpc.ErrorDesc(rpctypes.ErrGRPCNoLeader)
+}
+
+const (
+	// noLeaderBackoff is the wait before the first retry of a read that
+	// failed for lack of a leader; it doubles up to noLeaderMaxBackoff.
+	noLeaderBackoff    = 50 * time.Millisecond
+	noLeaderMaxBackoff = time.Second
+	// noLeaderTimeout bounds the retries of such a read if the client has
+	// no RequestTimeout.
+	noLeaderTimeout = 5 * time.Second
+	// noLeaderWriteWait is the wait before the single retry of a write that
+	// failed for lack of a leader.
+	noLeaderWriteWait = 200 * time.Millisecond
+)
+
+// noLeaderRetry tracks the retries of a request failing with no leader.
+type noLeaderRetry struct {
+	fails int
+	start time.Time
+	// switches counts the members a request requiring a leader moved off
+	switches int
+}
+
+// failover returns true if a request requiring a leader, which failed
+// because its member had none, should move on to another member. Each
+// endpoint is tried once.
+func (r *noLeaderRetry) failover(c *Client) bool {
+	r.switches++
+	return r.switches < len(c.Endpoints())
+}
+
+// wait waits for a leader to be elected after the request failed with no
+// leader on the member. Reads wait with a growing backoff until the
+// RequestTimeout has passed since the first such failure; writes wait once,
+// since a write rejected for lack of a leader was not applied. It returns
+// false if the request should not be retried on the same member.
+func (r *noLeaderRetry) wait(ctx context.Context, c *Client, isWrite bool) bool {
+	r.fails++
+	if r.fails == 1 {
+		r.start = time.Now()
+	}
+	var d time.Duration
+	if isWrite {
+		if r.fails > 1 {
+			return false
+		}
+		d = noLeaderWriteWait
+	} else {
+		timeout := c.cfg.RequestTimeout
+		if timeout <= 0 {
+			timeout = noLeaderTimeout
+		}
+		d = noLeaderBackoff << uint(r.fails-1)
+		if d > noLeaderMaxBackoff || d <= 0 {
+			d = noLeaderMaxBackoff
+		}
+		left := timeout - time.Since(r.start)
+		if left <= 0 {
+			return false
+		}
+		if d > left {
+			d = left
+		}
+	}
+	select {
+	case <-time.After(d):
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+type retryKVClient struct {
+	pb.KVClient
+	c *Client
+}
+
+// retryKVClient wraps kc to retry its requests according to the client's
+// RetryPolicy, if enabled by Config.RetryRPCs and not disabled by
+// Config.FailFast.
+func (c *Client) retryKVClient(kc pb.KVClient) pb.KVClient {
+	if !c.cfg.RetryRPCs || c.cfg.RetryPolicy == nil || c.cfg.FailFast {
+		return kc
+	}
+	return &retryKVClient{KVClient: kc, c: c}
+}
+
+func (rkv *retryKVClient) Range(ctx context.Context, in *pb.RangeRequest, opts ...grpc.CallOption) (resp *pb.RangeResponse, err error) {
+	err = rkv.c.retryRPC(ctx, false, func() (rerr error) {
+		resp, rerr = rkv.KVClient.Range(ctx, in, opts...)
+		return rerr
+	})
+	return resp, err
+}
+
+func (rkv *retryKVClient) Put(ctx context.Context, in *pb.PutRequest, opts ...grpc.CallOption) (resp *pb.PutResponse, err error) {
+	err = rkv.c.retryRPC(ctx, true, func() (rerr error) {
+		resp, rerr = rkv.KVClient.Put(ctx, in, opts...)
+		return rerr
+	})
+	return resp, err
+}
+
+func (rkv *retryKVClient) DeleteRange(ctx context.Context, in *pb.DeleteRangeRequest, opts ...grpc.CallOption) (resp *pb.DeleteRangeResponse, err error) {
+	err = rkv.c.retryRPC(ctx, true, func() (rerr error) {
+		resp, rerr = rkv.KVClient.DeleteRange(ctx, in, opts...)
+		return rerr
+	})
+	return resp, err
+}
+
+func (rkv *retryKVClient) Txn(ctx context.Context, in *pb.TxnRequest, opts ...grpc.CallOption) (resp *pb.TxnResponse, err error) {
+	err = rkv.c.retryRPC(ctx, isTxnWrite(in), func() (rerr error) {
+		resp, rerr = rkv.KVClient.Txn(ctx, in, opts...)
+		return rerr
+	})
+	return resp, err
+}
+
+func (rkv *retryKVClient) Compact(ctx context.Context, in *pb.CompactionRequest, opts ...grpc.CallOption) (resp *pb.CompactionResponse, err error) {
+	err = rkv.c.retryRPC(ctx, true, func() (rerr error) {
+		resp, rerr = rkv.KVClient.Compact(ctx, in, opts...)
+		return rerr
+	})
+	return resp, err
+}
+
+// isTxnWrite returns true if either branch of the txn modifies keys.
+func isTxnWrite(r *pb.TxnRequest) bool {
+	for _, reqs := range [][]*pb.RequestUnion{r.Success, r.Failure} {
+		for _, u := range reqs {
+			if u.GetRequestRange() == nil {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,381 @@
+// Copyright 2016 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clientv3
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/coreos/etcd/etcdserver/api/v3rpc/rpctypes"
+	pb "github.com/coreos/etcd/etcdserver/etcdserverpb"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	tests := []struct {
+		p       RetryPolicy
+		attempt int
+
+		w time.Duration
+	}{
+		{RetryPolicy{}, 1, 0},
+		{RetryPolicy{InitialBackoff: time.Second}, 1, time.Second},
+		{RetryPolicy{InitialBackoff: time.Second}, 5, time.Second},
+		{RetryPolicy{InitialBackoff: time.Second, Multiplier: 0.5}, 3, time.Second},
+		{RetryPolicy{InitialBackoff: time.Second, Multiplier: 2}, 1, time.Second},
+		{RetryPolicy{InitialBackoff: time.Second, Multiplier: 2}, 3, 4 * time.Second},
+		{RetryPolicy{InitialBackoff: time.Second, Multiplier: 2, MaxBackoff: 3 * time.Second}, 3, 3 * time.Second},
+		{RetryPolicy{InitialBackoff: time.Second, Multiplier: 2, MaxBackoff: 3 * time.Second}, 100, 3 * time.Second},
+	}
+	for i, tt := range tests {
+		if g := tt.p.backoff(tt.attempt); g != tt.w {
+			t.Errorf("#%d: backoff(%d) = %v, want %v", i, tt.attempt, g, tt.w)
+		}
+	}
+}
+
+func TestRetryPolicyBackoffJitter(t *testing.T) {
+	p := RetryPolicy{InitialBackoff: time.Second, Jitter: 0.2}
+	for i := 0; i < 100; i++ {
+		if g := p.backoff(1); g < 800*time.Millisecond || g > 1200*time.Millisecond {
+			t.Fatalf("backoff = %v, want in [800ms, 1.2s]", g)
+		}
+	}
+}
+
+func TestRetryPolicyBackoffFullJitter(t *testing.T) {
+	p := RetryPolicy{InitialBackoff: time.Second, Jitter: 0.2, FullJitter: true}
+	for i := 0; i < 100; i++ {
+		if g := p.backoff(1); g < 0 || g >= time.Second {
+			t.Fatalf("backoff = %v, want in [0, 1s)", g)
+		}
+	}
+}
+
+func TestRetryWait(t *testing.T) {
+	c := &Client{}
+	if err := c.retryWait(context.TODO(), 100, errors.New("fail")); err != nil {
+		t.Errorf("without policy, err = %v, want nil", err)
+	}
+
+	lerr := errors.New("fail")
+	c.cfg.RetryPolicy = &RetryPolicy{MaxAttempts: 3}
+	if err := c.retryWait(context.TODO(), 2, lerr); err != nil {
+		t.Errorf("err = %v, want nil", err)
+	}
+	err := c.retryWait(context.TODO(), 3, lerr)
+	rerr, ok := err.(*RetryError)
+	if !ok {
+		t.Fatalf("err = %v, want *RetryError", err)
+	}
+	if rerr.Attempts != 3 || rerr.Err != lerr {
+		t.Errorf("retry error = %+v, want 3 attempts failing with %v", rerr, lerr)
+	}
+
+	c.cfg.RetryPolicy = &RetryPolicy{InitialBackoff: time.Hour}
+	ctx, cancel := context.WithCancel(context.TODO())
+	cancel()
+	if err := c.retryWait(ctx, 1, lerr); err != context.Canceled {
+		t.Errorf("err = %v, want %v", err, context.Canceled)
+	}
+}
+
+// fakeKVClient fails each request with the next error in errs, then
+// succeeds.
+type fakeKVClient struct {
+	pb.KVClient
+	errs  []error
+	calls int
+}
+
+func (kc *fakeKVClient) next() error {
+	kc.calls++
+	if len(kc.errs) == 0 {
+		return nil
+	}
+	err := kc.errs[0]
+	kc.errs = kc.errs[1:]
+	return err
+}
+
+func (kc *fakeKVClient) Range(context.Context, *pb.RangeRequest, ...grpc.CallOption) (*pb.RangeResponse, error) {
+	if err := kc.next(); err != nil {
+		return nil, err
+	}
+	return &pb.RangeResponse{}, nil
+}
+
+func (kc *fakeKVClient) Put(context.Context, *pb.PutRequest, ...grpc.CallOption) (*pb.PutResponse, error) {
+	if err := kc.next(); err != nil {
+		return nil, err
+	}
+	return &pb.PutResponse{}, nil
+}
+
+func (kc *fakeKVClient) Compact(context.Context, *pb.CompactionRequest, ...grpc.CallOption) (*pb.CompactionResponse, error) {
+	if err := kc.next(); err != nil {
+		return nil, err
+	}
+	return &pb.CompactionResponse{}, nil
+}
+
+func TestRetryKVClient(t *testing.T) {
+	errTimeout := grpc.Errorf(codes.Internal, "etcdserver: request timed out")
+	errTransport := grpc.Errorf(codes.Unavailable, "transport is closing")
+
+	tests := []struct {
+		put  bool
+		errs []error
+
+		wcalls int
+		werr   error
+	}{
+		{false, []error{rpctypes.ErrGRPCNoLeader, errTimeout}, 3, nil},
+		{true, []error{rpctypes.ErrGRPCNoLeader, rpctypes.ErrGRPCNotCapable}, 3, nil},
+		// the put may have been applied
+		{true, []error{errTimeout}, 1, errTimeout},
+		// broken connections are left to the caller
+		{false, []error{errTransport}, 1, errTransport},
+		{false, []error{rpctypes.ErrGRPCCompacted}, 1, rpctypes.ErrGRPCCompacted},
+		// a new token needs a reconnect
+		{false, []error{rpctypes.ErrGRPCInvalidAuthToken}, 1, rpctypes.ErrGRPCInvalidAuthToken},
+	}
+	for i, tt := range tests {
+		c := &Client{cfg: Config{RetryRPCs: true, RetryPolicy: &RetryPolicy{}}}
+		fkc := &fakeKVClient{errs: tt.errs}
+		kc := c.retryKVClient(fkc)
+		var err error
+		if tt.put {
+			_, err = kc.Put(context.TODO(), &pb.PutRequest{})
+		} else {
+			_, err = kc.Range(context.TODO(), &pb.RangeRequest{})
+		}
+		if err != tt.werr {
+			t.Errorf("#%d: err = %v, want %v", i, err, tt.werr)
+		}
+		if fkc.calls != tt.wcalls {
+			t.Errorf("#%d: calls = %d, want %d", i, fkc.calls, tt.wcalls)
+		}
+	}
+
+	// the policy bounds the attempts
+	c := &Client{cfg: Config{RetryRPCs: true, RetryPolicy: &RetryPolicy{MaxAttempts: 2}}}
+	fkc := &fakeKVClient{errs: []error{rpctypes.ErrGRPCNoLeader, rpctypes.ErrGRPCNoLeader, rpctypes.ErrGRPCNoLeader}}
+	_, err := c.retryKVClient(fkc).Range(context.TODO(), &pb.RangeRequest{})
+	if rerr, ok := err.(*RetryError); !ok || rerr.Attempts != 2 {
+		t.Errorf("err = %v, want *RetryError after 2 attempts", err)
+	}
+
+	// disabled without a policy
+	c = &Client{cfg: Config{RetryRPCs: true}}
+	if kc := c.retryKVClient(fkc); kc != pb.KVClient(fkc) {
+		t.Errorf("expected unwrapped client without a retry policy")
+	}
+}
+
+func TestKVDoNoLeader(t *testing.T) {
+	nl := rpctypes.ErrGRPCNoLeader
+	tests := []struct {
+		op   Op
+		errs []error
+
+		wcalls int
+		werr   error
+	}{
+		{OpGet("foo"), []error{nl}, 2, nil},
+		{OpGet("foo"), []error{nl, nl, nl}, 4, nil},
+		// a write is retried once
+		{OpPut("foo", "bar"), []error{nl}, 2, nil},
+		{OpPut("foo", "bar"), []error{nl, nl}, 2, rpctypes.ErrNoLeader},
+		// no other endpoint to fail over to
+		{OpGet("foo", WithLeaderRequired()), []error{nl}, 1, rpctypes.ErrNoLeader},
+		{OpPut("foo", "bar", WithLeaderRequired()), []error{nl}, 1, rpctypes.ErrNoLeader},
+		{OpGet("foo", WithLeaderRequired()), nil, 1, nil},
+	}
+	for i, tt := range tests {
+		c := &Client{cfg: Config{Endpoints: []string{"a"}, RequestTimeout: 10 * time.Second}, conn: &grpc.ClientConn{}, cancel: func() {}}
+		kv := NewKV(c).(*kv)
+		fkc := &fakeKVClient{errs: tt.errs}
+		kv.remote = fkc
+
+		_, err := kv.Do(context.TODO(), tt.op)
+		if err != tt.werr {
+			t.Errorf("#%d: err = %v, want %v", i, err, tt.werr)
+		}
+		if fkc.calls != tt.wcalls {
+			t.Errorf("#%d: calls = %d, want %d", i, fkc.calls, tt.wcalls)
+		}
+	}
+}
+
+func TestIsTxnWrite(t *testing.T) {
+	get := &pb.RequestUnion{Request: &pb.RequestUnion_RequestRange{RequestRange: &pb.RangeRequest{}}}
+	put := &pb.RequestUnion{Request: &pb.RequestUnion_RequestPut{RequestPut: &pb.PutRequest{}}}
+	tests := []struct {
+		r *pb.TxnRequest
+		w bool
+	}{
+		{&pb.TxnRequest{}, false},
+		{&pb.TxnRequest{Success: []*pb.RequestUnion{get}, Failure: []*pb.RequestUnion{get}}, false},
+		{&pb.TxnRequest{Success: []*pb.RequestUnion{get, put}}, true},
+		{&pb.TxnRequest{Success: []*pb.RequestUnion{get}, Failure: []*pb.RequestUnion{put}}, true},
+	}
+	for i, tt := range tests {
+		if g := isTxnWrite(tt.r); g != tt.w {
+			t.Errorf("#%d: isTxnWrite = %v, want %v", i, g, tt.w)
+		}
+	}
+}
+
+func TestKVCompactRetry(t *testing.T) {
+	nl := rpctypes.ErrGRPCNoLeader
+	tests := []struct {
+		errs []error
+
+		wcalls int
+		werr   error
+	}{
+		{nil, 1, nil},
+		{[]error{nl}, 2, nil},
+		// the first attempt may have compacted
+		{[]error{nl, rpctypes.ErrGRPCCompacted}, 2, nil},
+		// already compacted before the call
+		{[]error{rpctypes.ErrGRPCCompacted}, 1, nil},
+		{[]error{nl, rpctypes.ErrGRPCFutureRev}, 2, rpctypes.ErrFutureRev},
+	}
+	for i, tt := range tests {
+		c := &Client{cfg: Config{RequestTimeout: 10 * time.Second}, conn: &grpc.ClientConn{}, cancel: func() {}}
+		kv := NewKV(c).(*kv)
+		fkc := &fakeKVClient{errs: tt.errs}
+		kv.remote = fkc
+
+		err := kv.Compact(context.TODO(), 5)
+		if err != tt.werr {
+			t.Errorf("#%d: err = %v, want %v", i, err, tt.werr)
+		}
+		if fkc.calls != tt.wcalls {
+			t.Errorf("#%d: calls = %d, want %d", i, fkc.calls, tt.wcalls)
+		}
+	}
+}
+
+func TestNewKVFromKVClient(t *testing.T) {
+	errTransport := grpc.Errorf(codes.Unavailable, "transport is closing")
+	tests := []struct {
+		op   Op
+		errs []error
+
+		wcalls int
+		werr   error
+	}{
+		{OpGet("foo"), nil, 1, nil},
+		// a read is retried on the same remote
+		{OpGet("foo"), []error{errTransport}, 2, nil},
+		{OpPut("foo", "bar"), []error{errTransport}, 1, rpctypes.Error(errTransport)},
+		{OpGet("foo"), []error{rpctypes.ErrGRPCCompacted}, 1, rpctypes.ErrCompacted},
+	}
+	for i, tt := range tests {
+		fkc := &fakeKVClient{errs: tt.errs}
+		kv := NewKVFromKVClient(fkc, Config{})
+
+		_, err := kv.Do(context.TODO(), tt.op)
+		if err != tt.werr {
+			t.Errorf("#%d: err = %v, want %v", i, err, tt.werr)
+		}
+		if fkc.calls != tt.wcalls {
+			t.Errorf("#%d: calls = %d, want %d", i, fkc.calls, tt.wcalls)
+		}
+	}
+}
+
+func TestKVCancelDuringReconnect(t *testing.T) {
+	errTransport := grpc.Errorf(codes.Unavailable, "transport is closing")
+	// no connMonitor runs, so the reconnect never completes
+	c := &Client{conn: &grpc.ClientConn{}, cancel: func() {}, reconnc: make(chan error, 1), newconnc: make(chan struct{})}
+	kv := NewKV(c).(*kv)
+	fkc := &fakeKVClient{errs: []error{errTransport}}
+	kv.remote = fkc
+
+	ctx, cancel := context.WithCancel(context.Background())
+	donec := make(chan error, 1)
+	go func() {
+		_, err := kv.Get(ctx, "foo")
+		donec <- err
+	}()
+	time.Sleep(100 * time.Millisecond)
+	cancel()
+	select {
+	case err := <-donec:
+		if err != context.Canceled {
+			t.Errorf("err = %v, want %v", err, context.Canceled)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("get not canceled while waiting for a reconnect")
+	}
+	if fkc.calls != 1 {
+		t.Errorf("calls = %d, want 1", fkc.calls)
+	}
+}
+
+func TestKVFailFast(t *testing.T) {
+	nl := rpctypes.ErrGRPCNoLeader
+	errTransport := grpc.Errorf(codes.Unavailable, "transport is closing")
+	tests := []struct {
+		op  Op
+		err error
+
+		werr error
+	}{
+		{OpGet("foo"), nl, rpctypes.ErrNoLeader},
+		{OpGet("foo"), errTransport, rpctypes.Error(errTransport)},
+		{OpPut("foo", "bar"), nl, rpctypes.ErrNoLeader},
+		{OpGet("foo"), rpctypes.ErrGRPCCompacted, rpctypes.ErrCompacted},
+	}
+	for i, tt := range tests {
+		cfg := Config{Endpoints: []string{"a", "b"}, FailFast: true, RetryRPCs: true, RetryPolicy: &RetryPolicy{}}
+		c := &Client{cfg: cfg, conn: &grpc.ClientConn{}, cancel: func() {}, reconnc: make(chan error, 1)}
+		kv := NewKV(c).(*kv)
+		fkc := &fakeKVClient{errs: []error{tt.err}}
+		kv.remote = c.retryKVClient(fkc)
+
+		_, err := kv.Do(context.TODO(), tt.op)
+		if err != tt.werr {
+			t.Errorf("#%d: err = %v, want %v", i, err, tt.werr)
+		}
+		if fkc.calls != 1 {
+			t.Errorf("#%d: calls = %d, want 1", i, fkc.calls)
+		}
+		if len(c.reconnc) != 0 {
+			t.Errorf("#%d: unexpected reconnect", i)
+		}
+	}
+
+	c := &Client{cfg: Config{FailFast: true}, conn: &grpc.ClientConn{}, cancel: func() {}, reconnc: make(chan error, 1)}
+	kv := NewKV(c).(*kv)
+	fkc := &fakeKVClient{errs: []error{nl}}
+	kv.remote = fkc
+	if err := kv.Compact(context.TODO(), 5); err != rpctypes.ErrNoLeader {
+		t.Errorf("compact err = %v, want %v", err, rpctypes.ErrNoLeader)
+	}
+	if len(c.reconnc) != 0 {
+		t.Errorf("compact: unexpected reconnect")
+	}
+	if fkc.calls != 1 {
+		t.Errorf("compact calls = %d, want 1", fkc.calls)
+	}
+}
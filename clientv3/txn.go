@@ -15,13 +15,49 @@
 package clientv3
 
 import (
+	"errors"
+	"fmt"
 	"sync"
+	"time"
 
 	"github.com/coreos/etcd/etcdserver/api/v3rpc/rpctypes"
 	pb "github.com/coreos/etcd/etcdserver/etcdserverpb"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc"
 )
 
+var (
+	errTxnIfTwice       = errors.New("etcdclient: cannot call If twice")
+	errTxnIfAfterThen   = errors.New("etcdclient: cannot call If after Then")
+	errTxnIfAfterElse   = errors.New("etcdclient: cannot call If after Else")
+	errTxnThenTwice     = errors.New("etcdclient: cannot call Then twice")
+	errTxnThenAfterElse = errors.New("etcdclient: cannot call Then after Else")
+	errTxnElseTwice     = errors.New("etcdclient: cannot call Else twice")
+	errTxnCommitNoThen  = errors.New("etcdclient: cannot call Commit before Then")
+	errTxnBadCompare    = errors.New("etcdclient: bad compare value")
+)
+
+// DefaultMaxTxnOps is the default limit on the comparisons, and on the
+// operations in each branch, of a transaction. It matches the server's.
+const DefaultMaxTxnOps = 128
+
+// TxnTooLargeError is returned by Commit and BuildTxn when a transaction has
+// more comparisons, or more operations in a branch, than Config.MaxTxnOps
+// allows; the server would reject it with rpctypes.ErrTooManyOps.
+type TxnTooLargeError struct {
+	// Part is the part of the transaction over the limit: "comparisons",
+	// "success operations" or "failure operations".
+	Part string
+	// N is the number of entries in Part.
+	N int
+	// Limit is the configured limit.
+	Limit int
+}
+
+func (e *TxnTooLargeError) Error() string {
+	return fmt.Sprintf("etcdclient: txn has %d %s, more than the limit of %d (Config.MaxTxnOps)", e.N, e.Part, e.Limit)
+}
+
 // Txn is the interface that wraps mini-transactions.
 //
 //	 Tx.If(
@@ -36,7 +72,9 @@ import (
 type Txn interface {
 	// If takes a list of comparison. If all comparisons passed in succeed,
 	// the operations passed into Then() will be executed. Or the operations
-	// passed into Else() will be executed.
+	// passed into Else() will be executed. If may be omitted to apply the
+	// operations passed into Then() atomically without a precondition; such
+	// a transaction always succeeds.
 	If(cs ...Cmp) Txn
 
 	// Then takes a list of operations. The Ops list will be executed, if the
@@ -47,10 +85,15 @@ type Txn interface {
 	// comparisons passed in If() fail.
 	Else(ops ...Op) Txn
 
-	// Commit tries to commit the transaction.
+	// Commit tries to commit the transaction. If the transaction was built
+	// out of order (e.g., Then called twice, or Commit called before Then),
+	// Commit returns an error without sending the transaction.
 	Commit() (*TxnResponse, error)
 
-	// TODO: add a Do for shortcut the txn without any condition?
+	// BuildTxn returns the request Commit would send, without sending it.
+	// It fails with the same errors as Commit if the transaction was built
+	// out of order.
+	BuildTxn() (*pb.TxnRequest, error)
 }
 
 type txn struct {
@@ -64,6 +107,9 @@ type txn struct {
 
 	isWrite bool
 
+	// err is the first error hit while building the transaction
+	err error
+
 	cmps []*pb.Compare
 
 	sus []*pb.RequestUnion
@@ -74,21 +120,32 @@ func (txn *txn) If(cs ...Cmp) Txn {
 	txn.mu.Lock()
 	defer txn.mu.Unlock()
 
+	if txn.err != nil {
+		return txn
+	}
+
 	if txn.cif {
-		panic("cannot call If twice!")
+		txn.err = errTxnIfTwice
+		return txn
 	}
 
 	if txn.cthen {
-		panic("cannot call If after Then!")
+		txn.err = errTxnIfAfterThen
+		return txn
 	}
 
 	if txn.celse {
-		panic("cannot call If after Else!")
+		txn.err = errTxnIfAfterElse
+		return txn
 	}
 
 	txn.cif = true
 
 	for i := range cs {
+		if cs[i].TargetUnion == nil {
+			txn.err = errTxnBadCompare
+			return txn
+		}
 		txn.cmps = append(txn.cmps, (*pb.Compare)(&cs[i]))
 	}
 
@@ -99,11 +156,16 @@ func (txn *txn) Then(ops ...Op) Txn {
 	txn.mu.Lock()
 	defer txn.mu.Unlock()
 
+	if txn.err != nil {
+		return txn
+	}
 	if txn.cthen {
-		panic("cannot call Then twice!")
+		txn.err = errTxnThenTwice
+		return txn
 	}
 	if txn.celse {
-		panic("cannot call Then after Else!")
+		txn.err = errTxnThenAfterElse
+		return txn
 	}
 
 	txn.cthen = true
@@ -120,8 +182,12 @@ func (txn *txn) Else(ops ...Op) Txn {
 	txn.mu.Lock()
 	defer txn.mu.Unlock()
 
+	if txn.err != nil {
+		return txn
+	}
 	if txn.celse {
-		panic("cannot call Else twice!")
+		txn.err = errTxnElseTwice
+		return txn
 	}
 
 	txn.celse = true
@@ -134,38 +200,178 @@ func (txn *txn) Else(ops ...Op) Txn {
 	return txn
 }
 
-func (txn *txn) Commit() (*TxnResponse, error) {
+func (txn *txn) BuildTxn() (*pb.TxnRequest, error) {
+	txn.mu.Lock()
+	defer txn.mu.Unlock()
+	return txn.build()
+}
+
+// build assembles the request of the transaction. txn.mu must be held.
+func (txn *txn) build() (*pb.TxnRequest, error) {
+	if txn.err != nil {
+		return nil, txn.err
+	}
+	if !txn.cthen {
+		return nil, errTxnCommitNoThen
+	}
+	if err := txn.checkSize(); err != nil {
+		return nil, err
+	}
+	return &pb.TxnRequest{Compare: txn.cmps, Success: txn.sus, Failure: txn.fas}, nil
+}
+
+// checkSize fails if the transaction is larger than the client's MaxTxnOps.
+func (txn *txn) checkSize() error {
+	limit := txn.kv.rc.client.cfg.MaxTxnOps
+	if limit == 0 {
+		limit = DefaultMaxTxnOps
+	}
+	if limit < 0 {
+		return nil
+	}
+	parts := []struct {
+		name string
+		n    int
+	}{
+		{"comparisons", len(txn.cmps)},
+		{"success operations", len(txn.sus)},
+		{"failure operations", len(txn.fas)},
+	}
+	for _, p := range parts {
+		if p.n > limit {
+			return &TxnTooLargeError{Part: p.name, N: p.n, Limit: limit}
+		}
+	}
+	return nil
+}
+
+func (txn *txn) Commit() (resp *TxnResponse, err error) {
 	txn.mu.Lock()
 	defer txn.mu.Unlock()
-	for {
-		resp, err := txn.commit()
+	r, err := txn.build()
+	if err != nil {
+		return nil, err
+	}
+	start := time.Now()
+	defer func() { txn.kv.rc.client.cfg.Metrics.observe("Txn", start, err) }()
+	requireLeader := requiresLeader(txn.ctx)
+	var nl noLeaderRetry
+	for attempt := 1; ; attempt++ {
+		if cerr := txn.ctx.Err(); cerr != nil {
+			return nil, cerr
+		}
+		actx, cancel := txn.kv.rc.client.withRequestTimeout(txn.ctx)
+		hctx, end := txn.kv.rc.client.startAttempt(actx, "Txn", nil, attempt)
+		resp, conn, err := txn.commit(hctx, r)
+		end(err)
+		cerr := actx.Err()
+		cancel()
 		if err == nil {
+			txn.kv.rc.client.observeRev(resp.Header)
 			return resp, err
 		}
+		if cerr != nil {
+			return nil, cerr
+		}
+		if txn.kv.rc.client.cfg.FailFast {
+			return nil, rpctypes.Error(err)
+		}
 		if isHaltErr(txn.ctx, err) {
 			return nil, rpctypes.Error(err)
 		}
+		if isNoLeaderErr(err) && requireLeader {
+			if !nl.failover(txn.kv.rc.client) {
+				return nil, rpctypes.Error(err)
+			}
+			if nerr := txn.kv.rc.reconnectWaitFrom(txn.ctx, conn, err); nerr != nil {
+				return nil, rpctypes.Error(nerr)
+			}
+			continue
+		}
+		if isNoLeaderErr(err) && nl.wait(txn.ctx, txn.kv.rc.client, txn.isWrite) {
+			continue
+		}
 		if txn.isWrite {
-			txn.kv.rc.reconnect(err)
+			txn.kv.rc.reconnectFrom(conn, err)
 			return nil, rpctypes.Error(err)
 		}
-		if nerr := txn.kv.rc.reconnectWait(txn.ctx, err); nerr != nil {
+		if nerr := txn.kv.rc.client.retryWait(txn.ctx, attempt, err); nerr != nil {
 			return nil, nerr
 		}
+		if nerr := txn.kv.rc.reconnectWaitFrom(txn.ctx, conn, err); nerr != nil {
+			return nil, rpctypes.Error(nerr)
+		}
 	}
 }
 
-func (txn *txn) commit() (*TxnResponse, error) {
-	rem, rerr := txn.kv.getRemote(txn.ctx)
+func (txn *txn) commit(ctx context.Context, r *pb.TxnRequest) (*TxnResponse, *grpc.ClientConn, error) {
+	rem, conn, rerr := txn.kv.getRemote(ctx)
 	if rerr != nil {
-		return nil, rerr
+		return nil, nil, rerr
 	}
 	defer txn.kv.rc.release()
 
-	r := &pb.TxnRequest{Compare: txn.cmps, Success: txn.sus, Failure: txn.fas}
-	resp, err := rem.Txn(txn.ctx, r)
+	resp, err := rem.Txn(ctx, r)
 	if err != nil {
-		return nil, err
+		return nil, conn, err
+	}
+	return (*TxnResponse)(resp), conn, nil
+}
+
+// CompareAndSwap sets key to newVal only if its current value is oldVal,
+// returning whether the swap happened. A missing key never matches; use
+// CompareAndCreate to create it.
+func CompareAndSwap(ctx context.Context, kv KV, key, oldVal, newVal string) (bool, error) {
+	resp, err := kv.Txn(ctx).
+		If(Compare(Value(key), "=", oldVal)).
+		Then(OpPut(key, newVal)).
+		Commit()
+	if err != nil {
+		return false, err
+	}
+	return resp.Succeeded, nil
+}
+
+// CompareAndCreate sets key to val only if the key does not exist,
+// returning whether the key was created.
+func CompareAndCreate(ctx context.Context, kv KV, key, val string) (bool, error) {
+	resp, err := kv.Txn(ctx).
+		If(Compare(CreateRevision(key), "=", 0)).
+		Then(OpPut(key, val)).
+		Commit()
+	if err != nil {
+		return false, err
+	}
+	return resp.Succeeded, nil
+}
+
+// CompareAndDelete deletes key only if its current value is val,
+// returning whether the key was deleted.
+func CompareAndDelete(ctx context.Context, kv KV, key, val string) (bool, error) {
+	resp, err := kv.Txn(ctx).
+		If(Compare(Value(key), "=", val)).
+		Then(OpDelete(key)).
+		Commit()
+	if err != nil {
+		return false, err
+	}
+	return resp.Succeeded, nil
+}
+
+// PutIfChanged puts key only if its current value differs from val, so
+// reconciling an unchanged value neither bumps its ModRevision nor
+// triggers watch events. A missing key is put. It returns whether the put
+// happened; opts apply to it, but do not count as a change themselves
+// (e.g., a new lease on an unchanged value is not attached).
+func PutIfChanged(ctx context.Context, kv KV, key, val string, opts ...OpOption) (bool, error) {
+	// a missing key fails the comparison, like a differing value
+	resp, err := kv.Txn(ctx).
+		If(Compare(Value(key), "=", val)).
+		Then().
+		Else(OpPut(key, val, opts...)).
+		Commit()
+	if err != nil {
+		return false, err
 	}
-	return (*TxnResponse)(resp), nil
+	return !resp.Succeeded, nil
 }
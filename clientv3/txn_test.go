@@ -15,87 +15,196 @@
 package clientv3
 
 import (
+	"reflect"
 	"testing"
-	"time"
+
+	pb "github.com/coreos/etcd/etcdserver/etcdserverpb"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
 )
 
-func TestTxnPanics(t *testing.T) {
+func TestTxnErrors(t *testing.T) {
 	kv := NewKV(&Client{})
 
-	errc := make(chan string)
-	df := func() {
-		if s := recover(); s != nil {
-			errc <- s.(string)
-		}
-	}
-
 	cmp := Compare(CreateRevision("foo"), "=", 0)
 	op := OpPut("foo", "bar")
 
 	tests := []struct {
-		f func()
+		txn Txn
 
-		err string
+		err error
 	}{
 		{
-			f: func() {
-				defer df()
-				kv.Txn(nil).If(cmp).If(cmp)
-			},
-
-			err: "cannot call If twice!",
+			txn: kv.Txn(nil).If(cmp).If(cmp).Then(op),
+			err: errTxnIfTwice,
 		},
 		{
-			f: func() {
-				defer df()
-				kv.Txn(nil).Then(op).If(cmp)
-			},
-
-			err: "cannot call If after Then!",
+			txn: kv.Txn(nil).Then(op).If(cmp),
+			err: errTxnIfAfterThen,
 		},
 		{
-			f: func() {
-				defer df()
-				kv.Txn(nil).Else(op).If(cmp)
-			},
-
-			err: "cannot call If after Else!",
+			txn: kv.Txn(nil).Else(op).If(cmp),
+			err: errTxnIfAfterElse,
 		},
 		{
-			f: func() {
-				defer df()
-				kv.Txn(nil).Then(op).Then(op)
-			},
-
-			err: "cannot call Then twice!",
+			txn: kv.Txn(nil).Then(op).Then(op),
+			err: errTxnThenTwice,
 		},
 		{
-			f: func() {
-				defer df()
-				kv.Txn(nil).Else(op).Then(op)
-			},
-
-			err: "cannot call Then after Else!",
+			txn: kv.Txn(nil).Else(op).Then(op),
+			err: errTxnThenAfterElse,
 		},
 		{
-			f: func() {
-				defer df()
-				kv.Txn(nil).Else(op).Else(op)
-			},
-
-			err: "cannot call Else twice!",
+			txn: kv.Txn(nil).Then(op).Else(op).Else(op),
+			err: errTxnElseTwice,
+		},
+		{
+			txn: kv.Txn(nil).If(cmp).Else(op),
+			err: errTxnCommitNoThen,
+		},
+		{
+			txn: kv.Txn(nil),
+			err: errTxnCommitNoThen,
+		},
+		{
+			txn: kv.Txn(nil).If(Compare(Value("foo"), "=", 1)).Then(op),
+			err: errTxnBadCompare,
 		},
+		{
+			txn: kv.Txn(nil).If(Compare(ModRevision("foo"), "!=", "bar")).Then(op),
+			err: errTxnBadCompare,
+		},
+		{
+			// the first error is kept
+			txn: kv.Txn(nil).Then(op).Then(op).If(cmp),
+			err: errTxnThenTwice,
+		},
+	}
+
+	for i, tt := range tests {
+		if r, err := tt.txn.BuildTxn(); err != tt.err || r != nil {
+			t.Errorf("#%d: BuildTxn = %+v, %v, want nil, %v", i, r, err, tt.err)
+		}
+		resp, err := tt.txn.Commit()
+		if err != tt.err {
+			t.Errorf("#%d: err = %v, want %v", i, err, tt.err)
+		}
+		if resp != nil {
+			t.Errorf("#%d: resp = %+v, want nil", i, resp)
+		}
+	}
+}
+
+func TestTxnBuild(t *testing.T) {
+	kv := NewKV(&Client{})
+
+	cmp := Compare(Version("foo"), ">", 1)
+	r, err := kv.Txn(nil).
+		If(cmp).
+		Then(OpPut("foo", "bar"), OpGet("foo")).
+		Else(OpDelete("foo")).
+		BuildTxn()
+	if err != nil {
+		t.Fatal(err)
+	}
+	w := &pb.TxnRequest{
+		Compare: []*pb.Compare{(*pb.Compare)(&cmp)},
+		Success: []*pb.RequestUnion{OpPut("foo", "bar").toRequestUnion(), OpGet("foo").toRequestUnion()},
+		Failure: []*pb.RequestUnion{OpDelete("foo").toRequestUnion()},
 	}
+	if !reflect.DeepEqual(r, w) {
+		t.Errorf("request = %+v, want %+v", r, w)
+	}
+}
+
+func TestTxnBuildNoIf(t *testing.T) {
+	kv := NewKV(&Client{})
+
+	r, err := kv.Txn(nil).Then(OpPut("foo", "bar"), OpDelete("abc")).BuildTxn()
+	if err != nil {
+		t.Fatal(err)
+	}
+	w := &pb.TxnRequest{
+		Success: []*pb.RequestUnion{OpPut("foo", "bar").toRequestUnion(), OpDelete("abc").toRequestUnion()},
+	}
+	if !reflect.DeepEqual(r, w) {
+		t.Errorf("request = %+v, want %+v", r, w)
+	}
+}
+
+func TestTxnTooLarge(t *testing.T) {
+	ops := make([]Op, DefaultMaxTxnOps+1)
+	cmps := make([]Cmp, DefaultMaxTxnOps+1)
+	for i := range ops {
+		ops[i] = OpPut("foo", "bar")
+		cmps[i] = Compare(Version("foo"), "=", 0)
+	}
+
+	tests := []struct {
+		limit int
+		txn   func(KV) Txn
+
+		werr error
+	}{
+		{0, func(kv KV) Txn { return kv.Txn(nil).Then(ops[:DefaultMaxTxnOps]...) }, nil},
+		{0, func(kv KV) Txn { return kv.Txn(nil).Then(ops...) }, &TxnTooLargeError{"success operations", DefaultMaxTxnOps + 1, DefaultMaxTxnOps}},
+		{0, func(kv KV) Txn { return kv.Txn(nil).If(cmps...).Then() }, &TxnTooLargeError{"comparisons", DefaultMaxTxnOps + 1, DefaultMaxTxnOps}},
+		{2, func(kv KV) Txn { return kv.Txn(nil).Then(ops[:2]...).Else(ops[:3]...) }, &TxnTooLargeError{"failure operations", 3, 2}},
+		// disabled
+		{-1, func(kv KV) Txn { return kv.Txn(nil).Then(ops...) }, nil},
+	}
+	for i, tt := range tests {
+		kv := NewKV(&Client{cfg: Config{MaxTxnOps: tt.limit}})
+		_, err := tt.txn(kv).BuildTxn()
+		if !reflect.DeepEqual(err, tt.werr) {
+			t.Errorf("#%d: err = %v, want %v", i, err, tt.werr)
+		}
+	}
+}
+
+// txnKVClient records the txn it is sent and answers with resp.
+type txnKVClient struct {
+	pb.KVClient
+	req  *pb.TxnRequest
+	resp *pb.TxnResponse
+}
+
+func (kc *txnKVClient) Txn(ctx context.Context, in *pb.TxnRequest, opts ...grpc.CallOption) (*pb.TxnResponse, error) {
+	kc.req = in
+	return kc.resp, nil
+}
 
+func TestPutIfChanged(t *testing.T) {
+	tests := []struct {
+		succeeded bool
+
+		wput bool
+	}{
+		// value unchanged
+		{true, false},
+		// value changed or key missing
+		{false, true},
+	}
 	for i, tt := range tests {
-		go tt.f()
-		select {
-		case err := <-errc:
-			if err != tt.err {
-				t.Errorf("#%d: got %s, wanted %s", i, err, tt.err)
-			}
-		case <-time.After(time.Second):
-			t.Errorf("#%d: did not panic, wanted panic %s", i, tt.err)
+		c := &Client{conn: &grpc.ClientConn{}, cancel: func() {}}
+		kv := NewKV(c).(*kv)
+		fkc := &txnKVClient{resp: &pb.TxnResponse{Header: &pb.ResponseHeader{}, Succeeded: tt.succeeded}}
+		kv.remote = fkc
+
+		put, err := PutIfChanged(context.TODO(), kv, "foo", "bar")
+		if err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+		if put != tt.wput {
+			t.Errorf("#%d: put = %v, want %v", i, put, tt.wput)
+		}
+		cmp := Compare(Value("foo"), "=", "bar")
+		w := &pb.TxnRequest{
+			Compare: []*pb.Compare{(*pb.Compare)(&cmp)},
+			Failure: []*pb.RequestUnion{OpPut("foo", "bar").toRequestUnion()},
+		}
+		if !reflect.DeepEqual(fkc.req, w) {
+			t.Errorf("#%d: request = %+v, want %+v", i, fkc.req, w)
 		}
 	}
 }
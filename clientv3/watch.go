@@ -15,8 +15,10 @@
 package clientv3
 
 import (
+	"errors"
 	"fmt"
 	"sync"
+	"time"
 
 	v3rpc "github.com/coreos/etcd/etcdserver/api/v3rpc/rpctypes"
 	pb "github.com/coreos/etcd/etcdserver/etcdserverpb"
@@ -30,18 +32,46 @@ const (
 	EventTypePut    = mvccpb.PUT
 )
 
+// ErrWatcherClosed is the reason given to the watches ended by closing
+// their Watcher.
+var ErrWatcherClosed = errors.New("etcdclient: watcher is closed")
+
+// closeSendErrTimeout is how long a watch that ends waits for its
+// subscriber to receive the response giving the reason.
+var closeSendErrTimeout = 250 * time.Millisecond
+
 type Event mvccpb.Event
 
 type WatchChan <-chan WatchResponse
 
 type Watcher interface {
 	// Watch watches on a key or prefix. The watched events will be returned
-	// through the returned channel.
+	// through the returned channel. The channel is closed when ctx is
+	// canceled or the watcher is closed.
 	// If the watch is slow or the required rev is compacted, the watch request
 	// might be canceled from the server-side and the chan will be closed.
-	// 'opts' can be: 'WithRev' and/or 'WithPrefix'.
+	// Before the channel is closed, it sends a final response that has
+	// Canceled set and whose Err() tells why the watch ended.
+	// The watches of a Watcher share a single grpc stream; canceling the
+	// ctx of one cancels it on the server and leaves the others running.
+	// 'opts' can be: 'WithRev', to start watching from a past revision;
+	// 'WithPrefix', 'WithRange' or 'WithFromKey', to watch a range of keys;
+	// 'WithProgressNotify'; 'WithFilterPut' or 'WithFilterDelete', to
+	// discard events of one type; 'WithPrevKV', to get the key-value
+	// each event replaced; 'WithBatchEvents', to coalesce events; and
+	// 'WithSnapshotRecovery', to recover from a compacted revision. An empty
+	// key with 'WithPrefix' watches all keys.
 	Watch(ctx context.Context, key string, opts ...OpOption) WatchChan
 
+	// RequestProgress asks the server to send a progress notification to
+	// the watches of the Watcher. Since the watches share a single stream,
+	// the request applies to all of them: each watch that has caught up with
+	// the store receives a response with no events whose Header.Revision is
+	// the current revision, while watches still receiving events get none
+	// and carry on. The request is lost if the stream fails before the
+	// server receives it.
+	RequestProgress(ctx context.Context) error
+
 	// Close closes the watcher and cancels all watch requests.
 	Close() error
 }
@@ -53,10 +83,19 @@ type WatchResponse struct {
 	// CompactRevision is the minimum revision the watcher may receive.
 	CompactRevision int64
 
+	// Snapshot is set on the response a watch with WithSnapshotRecovery
+	// sends after its revision was compacted. Its events are not changes
+	// from the store history but synthetic PUT events for every watched key
+	// at Header.Revision.
+	Snapshot bool
+
 	// Canceled is used to indicate watch failure.
 	// If the watch failed and the stream was about to close, before the channel is closed,
 	// the channel sends a final response that has Canceled set to true with a non-nil Err().
 	Canceled bool
+
+	// closeErr is the reason the watch ended, if not a compaction.
+	closeErr error
 }
 
 // IsCreate returns true if the event tells that the key is newly created.
@@ -69,8 +108,15 @@ func (e *Event) IsModify() bool {
 	return e.Type == EventTypePut && e.Kv.CreateRevision != e.Kv.ModRevision
 }
 
-// Err is the error value if this WatchResponse holds an error.
+// Err is the error value if this WatchResponse holds an error. On the
+// final response of a watch it tells why the watch ended: the error of the
+// watch context if it was canceled or timed out, ErrCompacted if the
+// revision to watch from was compacted, ErrWatcherClosed if the Watcher
+// was closed, or the error that broke the watcher otherwise.
 func (wr *WatchResponse) Err() error {
+	if wr.closeErr != nil {
+		return wr.closeErr
+	}
 	if wr.CompactRevision != 0 {
 		return v3rpc.ErrCompacted
 	}
@@ -81,8 +127,10 @@ func (wr *WatchResponse) Err() error {
 }
 
 // IsProgressNotify returns true if the WatchResponse is progress notification.
+// The Header.Revision of a progress notification is the store revision the
+// watcher has caught up to.
 func (wr *WatchResponse) IsProgressNotify() bool {
-	return len(wr.Events) == 0 && !wr.Canceled
+	return len(wr.Events) == 0 && !wr.Canceled && wr.CompactRevision == 0 && !wr.Snapshot
 }
 
 // watcher implements the Watcher interface
@@ -109,6 +157,21 @@ type watcher struct {
 	donec chan struct{}
 	// errc transmits errors from grpc Recv
 	errc chan error
+	// cancelc sends the watchers closed by their subscriber to the main
+	// goroutine, which cancels them on the server
+	cancelc chan *watcherStream
+	// progressc sends progress requests to the main goroutine
+	progressc chan struct{}
+	// closeErr is the reason given to the watches once donec is closed
+	closeErr error
+
+	c *Client
+	// requireLeader is set if the stream of the watcher requires a leader
+	requireLeader bool
+	// leaderw serves the watches requiring a leader, on a stream of its own
+	leaderw *watcher
+	// leaderMu protects leaderw
+	leaderMu sync.Mutex
 }
 
 // watchRequest is issued by the subscriber to start a new watcher
@@ -119,6 +182,14 @@ type watchRequest struct {
 	rev int64
 	// progressNotify is for progress updates.
 	progressNotify bool
+	// filters is the list of filters to apply on the server.
+	filters []pb.WatchCreateRequest_FilterType
+	// prevKV fetches the previous key-value pair of each event.
+	prevKV bool
+	// batchEvents coalesces the events of responses; see WithBatchEvents.
+	batchEvents bool
+	batchDelay  time.Duration
+	batchCount  int
 	// retc receives a chan WatchResponse once the watcher is established
 	retc chan chan WatchResponse
 }
@@ -132,15 +203,33 @@ type watcherStream struct {
 	// recvc buffers watch responses before publishing
 	recvc chan *WatchResponse
 	id    int64
+	// wc is the grpc stream the watcher was created on with id
+	wc pb.Watch_WatchClient
 
-	// lastRev is revision last successfully sent over outc
+	// lastRev is revision last successfully sent over outc; on resume,
+	// the watcher restarts from the revision following lastRev so events
+	// are neither dropped nor repeated
 	lastRev int64
 	// resumec indicates the stream must recover at a given revision
 	resumec chan int64
 }
 
 func NewWatcher(c *Client) Watcher {
-	ctx, cancel := context.WithCancel(context.Background())
+	return newWatcher(c, context.Background(), nil)
+}
+
+// NewWatchFromWatchClient returns a Watcher that opens its streams on remote
+// instead of over a client connection, e.g., to test code using Watcher
+// against a fake remote. Streams are reopened on remote as the client given
+// cfg would reopen them; its Endpoints and connection settings are ignored.
+func NewWatchFromWatchClient(remote pb.WatchClient, cfg Config) Watcher {
+	return newWatcher(newDetachedClient(cfg), context.Background(), remote)
+}
+
+// newWatcher returns a watcher whose streams are opened on remote, or on the
+// client's connection if remote is nil.
+func newWatcher(c *Client, pctx context.Context, remote pb.WatchClient) *watcher {
+	ctx, cancel := context.WithCancel(pctx)
 	w := &watcher{
 		ctx:     ctx,
 		cancel:  cancel,
@@ -151,10 +240,21 @@ func NewWatcher(c *Client) Watcher {
 		stopc: make(chan struct{}),
 		donec: make(chan struct{}),
 		errc:  make(chan error, 1),
+
+		cancelc:   make(chan *watcherStream),
+		progressc: make(chan struct{}),
+
+		c:             c,
+		requireLeader: requiresLeader(ctx),
 	}
 
-	f := func(conn *grpc.ClientConn) { w.remote = pb.NewWatchClient(conn) }
-	w.rc = newRemoteClient(c, f)
+	if remote != nil {
+		w.remote = remote
+		w.rc = newFixedRemoteClient(c)
+	} else {
+		f := func(conn *grpc.ClientConn) { w.remote = pb.NewWatchClient(conn) }
+		w.rc = newRemoteClient(c, f)
+	}
 
 	go w.run()
 	return w
@@ -163,6 +263,26 @@ func NewWatcher(c *Client) Watcher {
 // Watch posts a watch request to run() and waits for a new watcher channel
 func (w *watcher) Watch(ctx context.Context, key string, opts ...OpOption) WatchChan {
 	ow := opWatch(key, opts...)
+	if !w.requireLeader && (ow.requireLeader || requiresLeader(ctx)) {
+		// the server fails a whole stream for lack of a leader
+		return w.leaderWatcher().Watch(ctx, key, opts...)
+	}
+	if ow.snapshotRecovery && w.c.KV != nil {
+		return w.watchWithRecovery(ctx, ow)
+	}
+	return w.watch(ctx, ow)
+}
+
+// watch posts a watch request for ow to run() and waits for a new watcher
+// channel.
+func (w *watcher) watch(ctx context.Context, ow Op) WatchChan {
+	var filters []pb.WatchCreateRequest_FilterType
+	if ow.filterPut {
+		filters = append(filters, pb.WatchCreateRequest_NOPUT)
+	}
+	if ow.filterDelete {
+		filters = append(filters, pb.WatchCreateRequest_NODELETE)
+	}
 
 	retc := make(chan chan WatchResponse, 1)
 	wr := &watchRequest{
@@ -171,6 +291,11 @@ func (w *watcher) Watch(ctx context.Context, key string, opts ...OpOption) Watch
 		end:            string(ow.end),
 		rev:            ow.rev,
 		progressNotify: ow.progressNotify,
+		filters:        filters,
+		prevKV:         ow.prevKV,
+		batchEvents:    ow.batchEvents,
+		batchDelay:     ow.batchDelay,
+		batchCount:     ow.batchCount,
 		retc:           retc,
 	}
 
@@ -194,19 +319,138 @@ func (w *watcher) Watch(ctx context.Context, key string, opts ...OpOption) Watch
 		}
 	}
 
-	// couldn't create channel; return closed channel
-	ch := make(chan WatchResponse)
+	// couldn't create channel; return closed channel with the reason
+	closeErr := ctx.Err()
+	if closeErr == nil {
+		// donec is closed
+		closeErr = w.closeErr
+	}
+	ch := make(chan WatchResponse, 1)
+	ch <- WatchResponse{Canceled: true, closeErr: closeErr}
 	close(ch)
 	return ch
 }
 
+// watchWithRecovery forwards the responses of watches on ow, restarting the
+// watch from a snapshot of its keys whenever its revision is compacted.
+func (w *watcher) watchWithRecovery(ctx context.Context, ow Op) WatchChan {
+	outc := make(chan WatchResponse)
+	send := func(wr WatchResponse) bool {
+		select {
+		case outc <- wr:
+			return true
+		case <-ctx.Done():
+		case <-w.donec:
+		}
+		// give the subscriber a chance to receive the final response
+		select {
+		case outc <- wr:
+			return true
+		case <-time.After(closeSendErrTimeout):
+			return false
+		}
+	}
+	go func() {
+		defer close(outc)
+		wch := w.watch(ctx, ow)
+		for {
+			var compacted *WatchResponse
+			for wr := range wch {
+				if wr.CompactRevision != 0 && wr.closeErr == nil {
+					compacted = &wr
+					continue
+				}
+				if !send(wr) {
+					return
+				}
+			}
+			if compacted == nil {
+				return
+			}
+			resp, err := w.c.KV.Do(ctx, OpGet(string(ow.key), WithRange(string(ow.end))))
+			if err != nil {
+				send(WatchResponse{Header: compacted.Header, Canceled: true, closeErr: err})
+				return
+			}
+			gresp := resp.Get()
+			snap := WatchResponse{Header: *gresp.Header, Snapshot: true}
+			if !ow.filterPut {
+				for _, kv := range gresp.Kvs {
+					snap.Events = append(snap.Events, &Event{Type: EventTypePut, Kv: kv})
+				}
+			}
+			if !send(snap) {
+				return
+			}
+			ow.rev = gresp.Header.Revision + 1
+			wch = w.watch(ctx, ow)
+		}
+	}()
+	return outc
+}
+
+// leaderWatcher returns the watcher for the watches requiring a leader. Once
+// its stream fails for lack of a leader, a new one is started for later
+// watches.
+func (w *watcher) leaderWatcher() *watcher {
+	w.leaderMu.Lock()
+	defer w.leaderMu.Unlock()
+	select {
+	case <-w.donec:
+		// closed; let Watch report it
+		return w
+	default:
+	}
+	if w.leaderw != nil {
+		select {
+		case <-w.leaderw.donec:
+		default:
+			return w.leaderw
+		}
+	}
+	var remote pb.WatchClient
+	if w.rc.fixed {
+		remote = w.remote
+	}
+	w.leaderw = newWatcher(w.c, WithRequireLeader(context.Background()), remote)
+	return w.leaderw
+}
+
+// RequestProgress posts a progress request to run() for the shared stream
+func (w *watcher) RequestProgress(ctx context.Context) error {
+	w.leaderMu.Lock()
+	lw := w.leaderw
+	w.leaderMu.Unlock()
+	if lw != nil {
+		if err := lw.RequestProgress(ctx); err != nil && err != lw.closeErr {
+			return err
+		}
+	}
+	select {
+	case w.progressc <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-w.donec:
+		return w.closeErr
+	}
+}
+
 func (w *watcher) Close() error {
 	close(w.stopc)
 	<-w.donec
-	return v3rpc.Error(<-w.errc)
+	err := v3rpc.Error(<-w.errc)
+	w.leaderMu.Lock()
+	defer w.leaderMu.Unlock()
+	if w.leaderw != nil {
+		if lerr := w.leaderw.Close(); err == nil && !isNoLeaderErr(lerr) {
+			err = lerr
+		}
+	}
+	return err
 }
 
-func (w *watcher) addStream(resp *pb.WatchResponse, pendingReq *watchRequest) {
+func (w *watcher) addStream(wc pb.Watch_WatchClient, resp *pb.WatchResponse, pendingReq *watchRequest) {
 	if pendingReq == nil {
 		// no pending request; ignore
 		return
@@ -235,6 +479,7 @@ func (w *watcher) addStream(resp *pb.WatchResponse, pendingReq *watchRequest) {
 	ws := &watcherStream{
 		initReq: *pendingReq,
 		id:      resp.WatchId,
+		wc:      wc,
 		outc:    ret,
 		// buffered so unlikely to block on sending while holding mu
 		recvc:   make(chan *WatchResponse, 4),
@@ -244,7 +489,7 @@ func (w *watcher) addStream(resp *pb.WatchResponse, pendingReq *watchRequest) {
 	if pendingReq.rev == 0 {
 		// note the header revision so that a put following a current watcher
 		// disconnect will arrive on the watcher channel after reconnect
-		ws.initReq.rev = resp.Header.Revision
+		ws.initReq.rev = resp.Header.Revision + 1
 	}
 
 	w.mu.Lock()
@@ -279,6 +524,15 @@ func (w *watcher) run() {
 		case w.errc <- closeErr:
 		default:
 		}
+		select {
+		case <-w.stopc:
+			w.closeErr = ErrWatcherClosed
+		default:
+			w.closeErr = closeErr
+		}
+		if w.closeErr == nil {
+			w.closeErr = ErrWatcherClosed
+		}
 		close(w.donec)
 		w.cancel()
 	}()
@@ -291,6 +545,20 @@ func (w *watcher) run() {
 	var pendingReq, failedReq *watchRequest
 	curReqC := w.reqc
 	cancelSet := make(map[int64]struct{})
+	// sendCancel cancels the watch id on the server, once per stream
+	sendCancel := func(id int64) {
+		if _, ok := cancelSet[id]; ok {
+			return
+		}
+		cancelSet[id] = struct{}{}
+		cr := &pb.WatchRequest_CancelRequest{
+			CancelRequest: &pb.WatchCancelRequest{
+				WatchId: id,
+			},
+		}
+		req := &pb.WatchRequest{RequestUnion: cr}
+		wc.Send(req)
+	}
 
 	for {
 		select {
@@ -308,7 +576,7 @@ func (w *watcher) run() {
 			switch {
 			case pbresp.Created:
 				// response to pending req, try to add
-				w.addStream(pbresp, pendingReq)
+				w.addStream(wc, pbresp, pendingReq)
 				pendingReq = nil
 				curReqC = w.reqc
 			case pbresp.Canceled:
@@ -319,21 +587,27 @@ func (w *watcher) run() {
 					break
 				}
 				// watch response on unexpected watch id; cancel id
-				if _, ok := cancelSet[pbresp.WatchId]; ok {
-					break
-				}
-				cancelSet[pbresp.WatchId] = struct{}{}
-				cr := &pb.WatchRequest_CancelRequest{
-					CancelRequest: &pb.WatchCancelRequest{
-						WatchId: pbresp.WatchId,
-					},
-				}
-				req := &pb.WatchRequest{RequestUnion: cr}
-				wc.Send(req)
+				sendCancel(pbresp.WatchId)
+			}
+		// subscriber closed its watcher; the stream goes on for the others
+		case ws := <-w.cancelc:
+			if ws.wc == wc {
+				sendCancel(ws.id)
+			}
+		// RequestProgress() requested
+		case <-w.progressc:
+			pr := &pb.WatchRequest_ProgressRequest{
+				ProgressRequest: &pb.WatchProgressRequest{},
 			}
+			wc.Send(&pb.WatchRequest{RequestUnion: pr})
 		// watch client failed to recv; spawn another if possible
 		// TODO report watch client errors from errc?
-		case <-w.errc:
+		case err := <-w.errc:
+			if w.requireLeader && isNoLeaderErr(err) {
+				// fail the watches rather than wait for a leader
+				closeErr = v3rpc.Error(err)
+				return
+			}
 			if wc, closeErr = w.newWatchClient(); closeErr != nil {
 				return
 			}
@@ -404,8 +678,11 @@ func (w *watcher) serveWatchClient(wc pb.Watch_WatchClient) {
 func (w *watcher) serveStream(ws *watcherStream) {
 	emptyWr := &WatchResponse{}
 	wrs := []*WatchResponse{}
-	resuming := false
 	closing := false
+	var closeErr error
+	// holdc delays sending a batch with no responses queued behind it
+	// until more events have had a chance to arrive
+	var holdc <-chan time.Time
 	for !closing {
 		curWr := emptyWr
 		outc := ws.outc
@@ -414,6 +691,9 @@ func (w *watcher) serveStream(ws *watcherStream) {
 		} else {
 			outc = nil
 		}
+		if holdc != nil && len(wrs) == 1 {
+			outc = nil
+		}
 		select {
 		case outc <- *curWr:
 			if wrs[0].Err() != nil {
@@ -436,27 +716,28 @@ func (w *watcher) serveStream(ws *watcherStream) {
 				// shutdown from closeStream
 				return
 			}
-			// resume up to last seen event if disconnected
-			if resuming && wr.Err() == nil {
-				resuming = false
-				// trim events already seen
-				for i := 0; i < len(wr.Events); i++ {
-					if wr.Events[i].Kv.ModRevision > ws.lastRev {
-						wr.Events = wr.Events[i:]
-						break
-					}
-				}
-				// only forward new events
-				if wr.Events[0].Kv.ModRevision == ws.lastRev {
-					break
+			// TODO don't keep buffering if subscriber stops reading
+			if !ws.initReq.batchEvents {
+				wrs = append(wrs, wr)
+				break
+			}
+			var batched bool
+			if wrs, batched = ws.batch(wrs, wr); !batched {
+				// a new batch; hold it while events accumulate
+				holdc = nil
+				if isBatchable(wr) && ws.initReq.batchDelay > 0 {
+					holdc = time.After(ws.initReq.batchDelay)
 				}
 			}
-			resuming = false
-			// TODO don't keep buffering if subscriber stops reading
-			wrs = append(wrs, wr)
+			if n := ws.initReq.batchCount; n > 0 && len(wrs[len(wrs)-1].Events) >= n {
+				holdc = nil
+			}
+		case <-holdc:
+			holdc = nil
 		case resumeRev := <-ws.resumec:
-			wrs = nil
-			resuming = true
+			// unsent responses are received again after resuming
+			// from the revision following lastRev
+			wrs, holdc = nil, nil
 			if resumeRev == -1 {
 				// pause serving stream while resume gets set up
 				break
@@ -466,14 +747,68 @@ func (w *watcher) serveStream(ws *watcherStream) {
 			}
 		case <-w.donec:
 			closing = true
+			closeErr = w.closeErr
 		case <-ws.initReq.ctx.Done():
 			closing = true
+			closeErr = ws.initReq.ctx.Err()
+		}
+	}
+	if closeErr != nil {
+		timec := time.After(closeSendErrTimeout)
+		if ws.initReq.batchEvents {
+			// flush batched events before reporting the close
+			for len(wrs) > 0 && wrs[0].Err() == nil {
+				select {
+				case ws.outc <- *wrs[0]:
+					wrs = wrs[1:]
+				case <-timec:
+					wrs = nil
+				}
+			}
+		}
+		// tell the subscriber why the watch ended
+		select {
+		case ws.outc <- WatchResponse{Canceled: true, closeErr: closeErr}:
+		case <-timec:
 		}
 	}
 	w.mu.Lock()
 	w.closeStream(ws)
 	w.mu.Unlock()
-	// lazily send cancel message if events on missing id
+	// cancel on the server; events still arriving on the missing id
+	// are ignored
+	select {
+	case w.cancelc <- ws:
+	case <-w.donec:
+	}
+}
+
+// batch queues wr on wrs, merging its events into the last queued response
+// if both can be batched and the merged response stays within batchCount.
+// It reports whether wr was merged into an existing batch.
+func (ws *watcherStream) batch(wrs []*WatchResponse, wr *WatchResponse) ([]*WatchResponse, bool) {
+	if isBatchable(wr) && len(wrs) > 0 {
+		last := wrs[len(wrs)-1]
+		n := ws.initReq.batchCount
+		if isBatchable(last) && (n <= 0 || len(last.Events)+len(wr.Events) <= n) {
+			last.Header = wr.Header
+			last.Events = append(last.Events, wr.Events...)
+			return wrs, true
+		}
+	}
+	if isBatchable(wr) {
+		// copy so merging never writes through to the received response
+		cp := *wr
+		cp.Events = append([]*Event(nil), wr.Events...)
+		wr = &cp
+	}
+	return append(wrs, wr), false
+}
+
+// isBatchable returns true if wr only carries events that may be merged
+// with those of neighbouring responses.
+func isBatchable(wr *WatchResponse) bool {
+	return len(wr.Events) > 0 && !wr.Canceled && wr.CompactRevision == 0 && wr.Err() == nil
 }
 
 func (w *watcher) newWatchClient() (pb.Watch_WatchClient, error) {
@@ -508,7 +843,7 @@ func (w *watcher) openWatchClient() (ws pb.Watch_WatchClient, err error) {
 			return nil, err
 		default:
 		}
-		if ws, err = w.remote.Watch(w.ctx); ws != nil && err == nil {
+		if ws, err = w.remote.Watch(w.c.withMetadata(w.ctx)); ws != nil && err == nil {
 			break
 		} else if isHaltErr(w.ctx, err) {
 			return nil, v3rpc.Error(err)
@@ -533,7 +868,7 @@ func (w *watcher) resumeWatchers(wc pb.Watch_WatchClient) error {
 
 		// reconstruct watcher from initial request
 		if ws.lastRev != 0 {
-			ws.initReq.rev = ws.lastRev
+			ws.initReq.rev = ws.lastRev + 1
 		}
 		if err := wc.Send(ws.initReq.toPB()); err != nil {
 			return err
@@ -551,6 +886,7 @@ func (w *watcher) resumeWatchers(wc pb.Watch_WatchClient) error {
 		w.mu.Lock()
 		delete(w.streams, ws.id)
 		ws.id = resp.WatchId
+		ws.wc = wc
 		w.streams[ws.id] = ws
 		w.mu.Unlock()
 
@@ -567,6 +903,8 @@ func (wr *watchRequest) toPB() *pb.WatchRequest {
 		Key:            []byte(wr.key),
 		RangeEnd:       []byte(wr.end),
 		ProgressNotify: wr.progressNotify,
+		Filters:        wr.filters,
+		PrevKv:         wr.prevKV,
 	}
 	cr := &pb.WatchRequest_CreateRequest{CreateRequest: req}
 	return &pb.WatchRequest{RequestUnion: cr}
@@ -15,8 +15,10 @@
 package clientv3
 
 import (
+	"reflect"
 	"testing"
 
+	pb "github.com/coreos/etcd/etcdserver/etcdserverpb"
 	"github.com/coreos/etcd/mvcc/mvccpb"
 )
 
@@ -53,3 +55,58 @@ func TestEvent(t *testing.T) {
 		}
 	}
 }
+
+func TestWatchResponseIsProgressNotify(t *testing.T) {
+	tests := []struct {
+		wr WatchResponse
+		w  bool
+	}{
+		{WatchResponse{Header: pb.ResponseHeader{Revision: 5}}, true},
+		{WatchResponse{Events: []*Event{{Type: EventTypePut}}}, false},
+		{WatchResponse{Canceled: true}, false},
+		{WatchResponse{CompactRevision: 3}, false},
+	}
+	for i, tt := range tests {
+		if g := tt.wr.IsProgressNotify(); g != tt.w {
+			t.Errorf("#%d: IsProgressNotify() = %v, want %v", i, g, tt.w)
+		}
+	}
+}
+
+func TestWatcherStreamBatch(t *testing.T) {
+	ev := func(rev int64) *WatchResponse {
+		return &WatchResponse{
+			Header: pb.ResponseHeader{Revision: rev},
+			Events: []*Event{{Type: EventTypePut, Kv: &mvccpb.KeyValue{ModRevision: rev}}},
+		}
+	}
+	ws := &watcherStream{initReq: watchRequest{batchEvents: true, batchCount: 3}}
+	in := []*WatchResponse{
+		ev(2), ev(3), ev(4), ev(5),
+		{Header: pb.ResponseHeader{Revision: 5}},
+		ev(6),
+	}
+	var wrs []*WatchResponse
+	for _, wr := range in {
+		wrs, _ = ws.batch(wrs, wr)
+	}
+	if len(in[0].Events) != 1 {
+		t.Fatalf("received response modified, got %d events", len(in[0].Events))
+	}
+	wevs := [][]int64{{2, 3, 4}, {5}, nil, {6}}
+	if len(wrs) != len(wevs) {
+		t.Fatalf("got %d responses, want %d", len(wrs), len(wevs))
+	}
+	for i, wr := range wrs {
+		var revs []int64
+		for _, e := range wr.Events {
+			revs = append(revs, e.Kv.ModRevision)
+		}
+		if !reflect.DeepEqual(revs, wevs[i]) {
+			t.Errorf("#%d: revisions = %v, want %v", i, revs, wevs[i])
+		}
+		if len(revs) > 0 && wr.Header.Revision != revs[len(revs)-1] {
+			t.Errorf("#%d: header revision = %d, want %d", i, wr.Header.Revision, revs[len(revs)-1])
+		}
+	}
+}
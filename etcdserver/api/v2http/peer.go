@@ -50,6 +50,7 @@ func newPeerHandler(cluster api.Cluster, raftHandler http.Handler, leaseHandler
 	mux.Handle(peerMembersPrefix, mh)
 	if leaseHandler != nil {
 		mux.Handle(leasesPrefix, leaseHandler)
+		mux.Handle(leasehttp.LeaseTTLPrefix, leaseHandler)
 	}
 	mux.HandleFunc(versionPath, versionHandler(cluster, serveVersion))
 	return mux
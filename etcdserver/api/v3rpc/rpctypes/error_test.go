@@ -40,3 +40,34 @@ func TestConvert(t *testing.T) {
 		t.Fatalf("expected them to be equal, got %v / %v", grpc.Code(e2), e3.(EtcdError).Code())
 	}
 }
+
+func TestError(t *testing.T) {
+	tests := []struct {
+		err error
+
+		werr error
+	}{
+		{nil, nil},
+		{ErrGRPCCompacted, ErrCompacted},
+		{ErrGRPCFutureRev, ErrFutureRev},
+		{ErrGRPCNoSpace, ErrNoSpace},
+		{ErrGRPCRequestTooLarge, ErrRequestTooLarge},
+		{ErrGRPCLeaseNotFound, ErrLeaseNotFound},
+		{ErrGRPCNoLeader, ErrNoLeader},
+		// same code and message from a different server process
+		{grpc.Errorf(codes.OutOfRange, "etcdserver: mvcc: required revision has been compacted"), ErrCompacted},
+		// already converted
+		{ErrCompacted, ErrCompacted},
+	}
+	for i, tt := range tests {
+		if err := Error(tt.err); err != tt.werr {
+			t.Errorf("#%d: err = %v, want %v", i, err, tt.werr)
+		}
+	}
+
+	// unknown errors pass through unchanged
+	uerr := grpc.Errorf(codes.Unavailable, "transport is closing")
+	if err := Error(uerr); err != uerr {
+		t.Errorf("err = %v, want %v", err, uerr)
+	}
+}
@@ -47,6 +47,20 @@ func togRPCError(err error) error {
 		return rpctypes.ErrGRPCRoleNotFound
 	case auth.ErrAuthFailed:
 		return rpctypes.ErrGRPCAuthFailed
+	case auth.ErrRoleNotGranted:
+		return rpctypes.ErrGRPCRoleNotGranted
+	case auth.ErrPermNotGranted:
+		return rpctypes.ErrGRPCPermNotGranted
+	case etcdserver.ErrInvalidAuthToken:
+		return rpctypes.ErrGRPCInvalidAuthToken
+	case etcdserver.ErrKeyNotFound:
+		return rpctypes.ErrGRPCKeyNotFound
+	case etcdserver.ErrNotLeader:
+		return rpctypes.ErrGRPCNotLeader
+	case etcdserver.ErrBadLeaderTransferee:
+		return rpctypes.ErrGRPCBadLeaderTransferee
+	case etcdserver.ErrTimeoutLeaderTransfer:
+		return rpctypes.ErrGRPCTimeoutLeaderTransfer
 	default:
 		return grpc.Errorf(codes.Internal, err.Error())
 	}
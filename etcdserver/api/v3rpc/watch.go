@@ -32,7 +32,7 @@ type watchServer struct {
 	clusterID int64
 	memberID  int64
 	raftTimer etcdserver.RaftTimer
-	watchable mvcc.Watchable
+	watchable mvcc.WatchableKV
 }
 
 func NewWatchServer(s *etcdserver.EtcdServer) pb.WatchServer {
@@ -82,6 +82,8 @@ type serverWatchStream struct {
 	memberID  int64
 	raftTimer etcdserver.RaftTimer
 
+	watchable mvcc.WatchableKV
+
 	gRPCStream  pb.Watch_WatchServer
 	watchStream mvcc.WatchStream
 	ctrlStream  chan *pb.WatchResponse
@@ -89,9 +91,18 @@ type serverWatchStream struct {
 	// progress tracks the watchID that stream might need to send
 	// progress to.
 	progress map[mvcc.WatchID]bool
-	// mu protects progress
+	// filters tracks the event filters of each watchID.
+	filters map[mvcc.WatchID][]pb.WatchCreateRequest_FilterType
+	// prevKV tracks the watchID that needs the previous key-value pair
+	// of its events.
+	prevKV map[mvcc.WatchID]bool
+	// mu protects progress, filters and prevKV
 	mu sync.Mutex
 
+	// progressc signals the send loop to request the progress of all
+	// watchers on the stream on behalf of the client.
+	progressc chan struct{}
+
 	// closec indicates the stream is closed.
 	closec chan struct{}
 
@@ -104,11 +115,15 @@ func (ws *watchServer) Watch(stream pb.Watch_WatchServer) (err error) {
 		clusterID:   ws.clusterID,
 		memberID:    ws.memberID,
 		raftTimer:   ws.raftTimer,
+		watchable:   ws.watchable,
 		gRPCStream:  stream,
 		watchStream: ws.watchable.NewWatchStream(),
 		// chan for sending control response like watcher created and canceled.
 		ctrlStream: make(chan *pb.WatchResponse, ctrlStreamBufLen),
 		progress:   make(map[mvcc.WatchID]bool),
+		filters:    make(map[mvcc.WatchID][]pb.WatchCreateRequest_FilterType),
+		prevKV:     make(map[mvcc.WatchID]bool),
+		progressc:  make(chan struct{}, 1),
 		closec:     make(chan struct{}),
 	}
 
@@ -168,10 +183,22 @@ func (sws *serverWatchStream) recvLoop() error {
 			if rev == 0 {
 				rev = wsrev + 1
 			}
+			// hold mu so the send loop sees the filters before
+			// any event of the new watcher
+			sws.mu.Lock()
 			id := sws.watchStream.Watch(creq.Key, creq.RangeEnd, rev)
-			if id != -1 && creq.ProgressNotify {
-				sws.progress[id] = true
+			if id != -1 {
+				if creq.ProgressNotify {
+					sws.progress[id] = true
+				}
+				if len(creq.Filters) != 0 {
+					sws.filters[id] = creq.Filters
+				}
+				if creq.PrevKv {
+					sws.prevKV[id] = true
+				}
 			}
+			sws.mu.Unlock()
 			sws.ctrlStream <- &pb.WatchResponse{
 				Header:   sws.newResponseHeader(wsrev),
 				WatchId:  int64(id),
@@ -190,10 +217,20 @@ func (sws *serverWatchStream) recvLoop() error {
 					}
 					sws.mu.Lock()
 					delete(sws.progress, mvcc.WatchID(id))
+					delete(sws.filters, mvcc.WatchID(id))
+					delete(sws.prevKV, mvcc.WatchID(id))
 					sws.mu.Unlock()
 				}
 			}
 			// TODO: do we need to return error back to client?
+		case *pb.WatchRequest_ProgressRequest:
+			if uv.ProgressRequest != nil {
+				select {
+				case sws.progressc <- struct{}{}:
+				default:
+					// a progress request is already pending
+				}
+			}
 		default:
 			panic("not implemented")
 		}
@@ -220,10 +257,26 @@ func (sws *serverWatchStream) sendLoop() {
 			// TODO: evs is []mvccpb.Event type
 			// either return []*mvccpb.Event from the mvcc package
 			// or define protocol buffer with []mvccpb.Event.
+			sws.mu.Lock()
+			filters := sws.filters[wresp.WatchID]
+			needPrevKV := sws.prevKV[wresp.WatchID]
+			sws.mu.Unlock()
+
 			evs := wresp.Events
-			events := make([]*mvccpb.Event, len(evs))
+			events := make([]*mvccpb.Event, 0, len(evs))
 			for i := range evs {
-				events[i] = &evs[i]
+				if isFiltered(evs[i], filters) {
+					continue
+				}
+				if needPrevKV {
+					sws.fillPrevKV(&evs[i])
+				}
+				events = append(events, &evs[i])
+			}
+			if len(evs) != 0 && len(events) == 0 {
+				// all events are filtered out; nothing to send
+				mvcc.ReportEventReceived()
+				continue
 			}
 
 			wr := &pb.WatchResponse{
@@ -277,6 +330,12 @@ func (sws *serverWatchStream) sendLoop() {
 				}
 				delete(pending, wid)
 			}
+		case <-sws.progressc:
+			// only synced watchers respond; the others are
+			// still sending events
+			for id := range ids {
+				sws.watchStream.RequestProgress(id)
+			}
 		case <-progressTicker.C:
 			for id, ok := range sws.progress {
 				if ok {
@@ -298,6 +357,30 @@ func (sws *serverWatchStream) sendLoop() {
 	}
 }
 
+// isFiltered returns true if ev should not be sent to a watcher
+// created with the given filters.
+func isFiltered(ev mvccpb.Event, filters []pb.WatchCreateRequest_FilterType) bool {
+	for _, f := range filters {
+		switch {
+		case f == pb.WatchCreateRequest_NOPUT && ev.Type == mvccpb.PUT:
+			return true
+		case f == pb.WatchCreateRequest_NODELETE && ev.Type == mvccpb.DELETE:
+			return true
+		}
+	}
+	return false
+}
+
+// fillPrevKV sets the key-value pair of ev's key as of the revision
+// before ev. It is left unset if the key did not exist or the revision
+// is compacted.
+func (sws *serverWatchStream) fillPrevKV(ev *mvccpb.Event) {
+	kvs, _, err := sws.watchable.Range(ev.Kv.Key, nil, 1, ev.Kv.ModRevision-1)
+	if err == nil && len(kvs) != 0 {
+		ev.PrevKv = &kvs[0]
+	}
+}
+
 func (sws *serverWatchStream) close() {
 	sws.watchStream.Close()
 	close(sws.closec)
@@ -61,8 +61,11 @@ type applierV3 interface {
 	UserDelete(ua *pb.AuthUserDeleteRequest) (*pb.AuthUserDeleteResponse, error)
 	UserChangePassword(ua *pb.AuthUserChangePasswordRequest) (*pb.AuthUserChangePasswordResponse, error)
 	UserGrant(ua *pb.AuthUserGrantRequest) (*pb.AuthUserGrantResponse, error)
+	UserRevoke(ua *pb.AuthUserRevokeRequest) (*pb.AuthUserRevokeResponse, error)
 	RoleAdd(ua *pb.AuthRoleAddRequest) (*pb.AuthRoleAddResponse, error)
+	RoleDelete(ua *pb.AuthRoleDeleteRequest) (*pb.AuthRoleDeleteResponse, error)
 	RoleGrant(ua *pb.AuthRoleGrantRequest) (*pb.AuthRoleGrantResponse, error)
+	RoleRevoke(ua *pb.AuthRoleRevokeRequest) (*pb.AuthRoleRevokeResponse, error)
 }
 
 type applierV3backend struct {
@@ -73,7 +76,7 @@ func (s *EtcdServer) applyV3Request(r *pb.InternalRaftRequest) *applyResult {
 	ar := &applyResult{}
 	switch {
 	case r.Range != nil:
-		if s.AuthStore().IsRangePermitted(r.Header, string(r.Range.Key)) {
+		if s.AuthStore().IsRangePermitted(r.Header, string(r.Range.Key), string(r.Range.RangeEnd)) {
 			ar.resp, ar.err = s.applyV3.Range(noTxn, r.Range)
 		} else {
 			ar.err = rpctypes.ErrGRPCPermissionDenied
@@ -110,10 +113,16 @@ func (s *EtcdServer) applyV3Request(r *pb.InternalRaftRequest) *applyResult {
 		ar.resp, ar.err = s.applyV3.UserChangePassword(r.AuthUserChangePassword)
 	case r.AuthUserGrant != nil:
 		ar.resp, ar.err = s.applyV3.UserGrant(r.AuthUserGrant)
+	case r.AuthUserRevoke != nil:
+		ar.resp, ar.err = s.applyV3.UserRevoke(r.AuthUserRevoke)
 	case r.AuthRoleAdd != nil:
 		ar.resp, ar.err = s.applyV3.RoleAdd(r.AuthRoleAdd)
+	case r.AuthRoleDelete != nil:
+		ar.resp, ar.err = s.applyV3.RoleDelete(r.AuthRoleDelete)
 	case r.AuthRoleGrant != nil:
 		ar.resp, ar.err = s.applyV3.RoleGrant(r.AuthRoleGrant)
+	case r.AuthRoleRevoke != nil:
+		ar.resp, ar.err = s.applyV3.RoleRevoke(r.AuthRoleRevoke)
 	default:
 		panic("not implemented")
 	}
@@ -127,19 +136,51 @@ func (a *applierV3backend) Put(txnID int64, p *pb.PutRequest) (*pb.PutResponse,
 		rev int64
 		err error
 	)
+
+	var prev *mvccpb.KeyValue
+	if p.IgnoreValue || p.IgnoreLease || p.PrevKv {
+		var kvs []mvccpb.KeyValue
+		if txnID != noTxn {
+			kvs, _, err = a.s.KV().TxnRange(txnID, p.Key, nil, 1, 0)
+		} else {
+			kvs, _, err = a.s.KV().Range(p.Key, nil, 1, 0)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(kvs) != 0 {
+			prev = &kvs[0]
+		}
+	}
+	if p.PrevKv {
+		resp.PrevKv = prev
+	}
+
+	val, leaseID := p.Value, lease.LeaseID(p.Lease)
+	if p.IgnoreValue || p.IgnoreLease {
+		if prev == nil {
+			return nil, ErrKeyNotFound
+		}
+		if p.IgnoreValue {
+			val = prev.Value
+		}
+		if p.IgnoreLease {
+			leaseID = lease.LeaseID(prev.Lease)
+		}
+	}
+
 	if txnID != noTxn {
-		rev, err = a.s.KV().TxnPut(txnID, p.Key, p.Value, lease.LeaseID(p.Lease))
+		rev, err = a.s.KV().TxnPut(txnID, p.Key, val, leaseID)
 		if err != nil {
 			return nil, err
 		}
 	} else {
-		leaseID := lease.LeaseID(p.Lease)
 		if leaseID != lease.NoLease {
 			if l := a.s.lessor.Lookup(leaseID); l == nil {
 				return nil, lease.ErrLeaseNotFound
 			}
 		}
-		rev = a.s.KV().Put(p.Key, p.Value, leaseID)
+		rev = a.s.KV().Put(p.Key, val, leaseID)
 	}
 	resp.Header.Revision = rev
 	return resp, nil
@@ -159,6 +200,21 @@ func (a *applierV3backend) DeleteRange(txnID int64, dr *pb.DeleteRangeRequest) (
 		dr.RangeEnd = []byte{}
 	}
 
+	if dr.PrevKv {
+		var kvs []mvccpb.KeyValue
+		if txnID != noTxn {
+			kvs, _, err = a.s.KV().TxnRange(txnID, dr.Key, dr.RangeEnd, 0, 0)
+		} else {
+			kvs, _, err = a.s.KV().Range(dr.Key, dr.RangeEnd, 0, 0)
+		}
+		if err != nil {
+			return nil, err
+		}
+		for i := range kvs {
+			resp.PrevKvs = append(resp.PrevKvs, &kvs[i])
+		}
+	}
+
 	if txnID != noTxn {
 		n, rev, err = a.s.KV().TxnDeleteRange(txnID, dr.Key, dr.RangeEnd)
 		if err != nil {
@@ -188,8 +244,10 @@ func (a *applierV3backend) Range(txnID int64, r *pb.RangeRequest) (*pb.RangeResp
 	}
 
 	limit := r.Limit
-	if r.SortOrder != pb.RangeRequest_NONE {
-		// fetch everything; sort and truncate afterwards
+	if r.SortOrder != pb.RangeRequest_NONE || r.CountOnly ||
+		r.MinCreateRevision != 0 || r.MaxCreateRevision != 0 ||
+		r.MinModRevision != 0 || r.MaxModRevision != 0 {
+		// fetch everything; filter, sort and truncate afterwards
 		limit = 0
 	}
 	if limit > 0 {
@@ -209,6 +267,25 @@ func (a *applierV3backend) Range(txnID int64, r *pb.RangeRequest) (*pb.RangeResp
 		}
 	}
 
+	if r.MinCreateRevision != 0 {
+		kvs = pruneKVs(kvs, func(kv *mvccpb.KeyValue) bool { return kv.CreateRevision < r.MinCreateRevision })
+	}
+	if r.MaxCreateRevision != 0 {
+		kvs = pruneKVs(kvs, func(kv *mvccpb.KeyValue) bool { return kv.CreateRevision > r.MaxCreateRevision })
+	}
+	if r.MinModRevision != 0 {
+		kvs = pruneKVs(kvs, func(kv *mvccpb.KeyValue) bool { return kv.ModRevision < r.MinModRevision })
+	}
+	if r.MaxModRevision != 0 {
+		kvs = pruneKVs(kvs, func(kv *mvccpb.KeyValue) bool { return kv.ModRevision > r.MaxModRevision })
+	}
+
+	if r.CountOnly {
+		resp.Header.Revision = rev
+		resp.Count = int64(len(kvs))
+		return resp, nil
+	}
+
 	if r.SortOrder != pb.RangeRequest_NONE {
 		var sorter sort.Interface
 		switch {
@@ -238,6 +315,9 @@ func (a *applierV3backend) Range(txnID int64, r *pb.RangeRequest) (*pb.RangeResp
 
 	resp.Header.Revision = rev
 	for i := range kvs {
+		if r.KeysOnly {
+			kvs[i].Value = nil
+		}
 		resp.Kvs = append(resp.Kvs, &kvs[i])
 	}
 	return resp, nil
@@ -261,6 +341,9 @@ func (a *applierV3backend) Txn(rt *pb.TxnRequest) (*pb.TxnResponse, error) {
 	if err := a.checkRequestLeases(reqs); err != nil {
 		return nil, err
 	}
+	if err := a.checkRequestPut(reqs); err != nil {
+		return nil, err
+	}
 	if err := a.checkRequestRange(reqs); err != nil {
 		return nil, err
 	}
@@ -362,6 +445,10 @@ func (a *applierV3backend) applyCompare(c *pb.Compare) (int64, bool) {
 		if result != -1 {
 			return rev, false
 		}
+	case pb.Compare_NOT_EQUAL:
+		if result == 0 {
+			return rev, false
+		}
 	}
 	return rev, true
 }
@@ -532,14 +619,26 @@ func (a *applierV3backend) UserGrant(r *pb.AuthUserGrantRequest) (*pb.AuthUserGr
 	return a.s.AuthStore().UserGrant(r)
 }
 
+func (a *applierV3backend) UserRevoke(r *pb.AuthUserRevokeRequest) (*pb.AuthUserRevokeResponse, error) {
+	return a.s.AuthStore().UserRevoke(r)
+}
+
 func (a *applierV3backend) RoleAdd(r *pb.AuthRoleAddRequest) (*pb.AuthRoleAddResponse, error) {
 	return a.s.AuthStore().RoleAdd(r)
 }
 
+func (a *applierV3backend) RoleDelete(r *pb.AuthRoleDeleteRequest) (*pb.AuthRoleDeleteResponse, error) {
+	return a.s.AuthStore().RoleDelete(r)
+}
+
 func (a *applierV3backend) RoleGrant(r *pb.AuthRoleGrantRequest) (*pb.AuthRoleGrantResponse, error) {
 	return a.s.AuthStore().RoleGrant(r)
 }
 
+func (a *applierV3backend) RoleRevoke(r *pb.AuthRoleRevokeRequest) (*pb.AuthRoleRevokeResponse, error) {
+	return a.s.AuthStore().RoleRevoke(r)
+}
+
 type quotaApplierV3 struct {
 	applierV3
 	q Quota
@@ -576,6 +675,20 @@ func (a *quotaApplierV3) LeaseGrant(lc *pb.LeaseGrantRequest) (*pb.LeaseGrantRes
 	return resp, err
 }
 
+// pruneKVs removes the key-values for which isPrunable returns true,
+// reusing the backing array of kvs.
+func pruneKVs(kvs []mvccpb.KeyValue, isPrunable func(*mvccpb.KeyValue) bool) []mvccpb.KeyValue {
+	j := 0
+	for i := range kvs {
+		if isPrunable(&kvs[i]) {
+			continue
+		}
+		kvs[j] = kvs[i]
+		j++
+	}
+	return kvs[:j]
+}
+
 type kvSort struct{ kvs []mvccpb.KeyValue }
 
 func (s *kvSort) Swap(i, j int) {
@@ -622,7 +735,7 @@ func (a *applierV3backend) checkRequestLeases(reqs []*pb.RequestUnion) error {
 			continue
 		}
 		preq := tv.RequestPut
-		if preq == nil || lease.LeaseID(preq.Lease) == lease.NoLease {
+		if preq == nil || preq.IgnoreLease || lease.LeaseID(preq.Lease) == lease.NoLease {
 			continue
 		}
 		if l := a.s.lessor.Lookup(lease.LeaseID(preq.Lease)); l == nil {
@@ -632,6 +745,27 @@ func (a *applierV3backend) checkRequestLeases(reqs []*pb.RequestUnion) error {
 	return nil
 }
 
+func (a *applierV3backend) checkRequestPut(reqs []*pb.RequestUnion) error {
+	for _, requ := range reqs {
+		tv, ok := requ.Request.(*pb.RequestUnion_RequestPut)
+		if !ok {
+			continue
+		}
+		preq := tv.RequestPut
+		if preq == nil || (!preq.IgnoreValue && !preq.IgnoreLease) {
+			continue
+		}
+		kvs, _, err := a.s.KV().Range(preq.Key, nil, 1, 0)
+		if err != nil {
+			return err
+		}
+		if len(kvs) == 0 {
+			return ErrKeyNotFound
+		}
+	}
+	return nil
+}
+
 func (a *applierV3backend) checkRequestRange(reqs []*pb.RequestUnion) error {
 	for _, requ := range reqs {
 		tv, ok := requ.Request.(*pb.RequestUnion_RequestRange)
@@ -31,6 +31,10 @@ var (
 	ErrRequestTooLarge            = errors.New("etcdserver: request is too large")
 	ErrNoSpace                    = errors.New("etcdserver: no space")
 	ErrInvalidAuthToken           = errors.New("etcdserver: invalid auth token")
+	ErrKeyNotFound                = errors.New("etcdserver: key not found")
+	ErrNotLeader                  = errors.New("etcdserver: not leader")
+	ErrBadLeaderTransferee        = errors.New("etcdserver: bad leader transferee")
+	ErrTimeoutLeaderTransfer      = errors.New("etcdserver: request timed out, leader transfer took too long")
 )
 
 type DiscoveryError struct {
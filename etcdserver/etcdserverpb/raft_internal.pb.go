@@ -53,6 +53,9 @@ type InternalRaftRequest struct {
 	AuthRoleGrant          *AuthRoleGrantRequest          `protobuf:"bytes,17,opt,name=auth_role_grant,json=authRoleGrant" json:"auth_role_grant,omitempty"`
 	Authenticate           *AuthenticateRequest           `protobuf:"bytes,18,opt,name=authenticate" json:"authenticate,omitempty"`
 	Alarm                  *AlarmRequest                  `protobuf:"bytes,19,opt,name=alarm" json:"alarm,omitempty"`
+	AuthUserRevoke         *AuthUserRevokeRequest         `protobuf:"bytes,20,opt,name=auth_user_revoke,json=authUserRevoke" json:"auth_user_revoke,omitempty"`
+	AuthRoleDelete         *AuthRoleDeleteRequest         `protobuf:"bytes,21,opt,name=auth_role_delete,json=authRoleDelete" json:"auth_role_delete,omitempty"`
+	AuthRoleRevoke         *AuthRoleRevokeRequest         `protobuf:"bytes,22,opt,name=auth_role_revoke,json=authRoleRevoke" json:"auth_role_revoke,omitempty"`
 }
 
 func (m *InternalRaftRequest) Reset()                    { *m = InternalRaftRequest{} }
@@ -310,17 +313,53 @@ func (m *InternalRaftRequest) MarshalTo(data []byte) (int, error) {
 		}
 		i += n18
 	}
+	if m.AuthUserRevoke != nil {
+		data[i] = 0xa2
+		i++
+		data[i] = 0x1
+		i++
+		i = encodeVarintRaftInternal(data, i, uint64(m.AuthUserRevoke.Size()))
+		n19, err := m.AuthUserRevoke.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n19
+	}
+	if m.AuthRoleDelete != nil {
+		data[i] = 0xaa
+		i++
+		data[i] = 0x1
+		i++
+		i = encodeVarintRaftInternal(data, i, uint64(m.AuthRoleDelete.Size()))
+		n20, err := m.AuthRoleDelete.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n20
+	}
+	if m.AuthRoleRevoke != nil {
+		data[i] = 0xb2
+		i++
+		data[i] = 0x1
+		i++
+		i = encodeVarintRaftInternal(data, i, uint64(m.AuthRoleRevoke.Size()))
+		n21, err := m.AuthRoleRevoke.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n21
+	}
 	if m.Header != nil {
 		data[i] = 0xa2
 		i++
 		data[i] = 0x6
 		i++
 		i = encodeVarintRaftInternal(data, i, uint64(m.Header.Size()))
-		n19, err := m.Header.MarshalTo(data[i:])
+		n22, err := m.Header.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n19
+		i += n22
 	}
 	return i, nil
 }
@@ -461,6 +500,18 @@ func (m *InternalRaftRequest) Size() (n int) {
 		l = m.Alarm.Size()
 		n += 2 + l + sovRaftInternal(uint64(l))
 	}
+	if m.AuthUserRevoke != nil {
+		l = m.AuthUserRevoke.Size()
+		n += 2 + l + sovRaftInternal(uint64(l))
+	}
+	if m.AuthRoleDelete != nil {
+		l = m.AuthRoleDelete.Size()
+		n += 2 + l + sovRaftInternal(uint64(l))
+	}
+	if m.AuthRoleRevoke != nil {
+		l = m.AuthRoleRevoke.Size()
+		n += 2 + l + sovRaftInternal(uint64(l))
+	}
 	if m.Header != nil {
 		l = m.Header.Size()
 		n += 2 + l + sovRaftInternal(uint64(l))
@@ -1227,6 +1278,105 @@ func (m *InternalRaftRequest) Unmarshal(data []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthUserRevoke", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AuthUserRevoke == nil {
+				m.AuthUserRevoke = &AuthUserRevokeRequest{}
+			}
+			if err := m.AuthUserRevoke.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthRoleDelete", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AuthRoleDelete == nil {
+				m.AuthRoleDelete = &AuthRoleDeleteRequest{}
+			}
+			if err := m.AuthRoleDelete.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthRoleRevoke", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AuthRoleRevoke == nil {
+				m.AuthRoleRevoke = &AuthRoleRevokeRequest{}
+			}
+			if err := m.AuthRoleRevoke.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 100:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
@@ -1437,43 +1587,45 @@ var (
 )
 
 var fileDescriptorRaftInternal = []byte{
-	// 593 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x74, 0x94, 0xcf, 0x6e, 0xd3, 0x40,
-	0x10, 0xc6, 0x69, 0xda, 0x86, 0x66, 0xf3, 0x97, 0x0d, 0xa0, 0x25, 0x48, 0xa5, 0x04, 0x21, 0x21,
-	0x90, 0x02, 0x6a, 0x8f, 0x1c, 0x20, 0x24, 0x11, 0x14, 0x81, 0x54, 0x59, 0x70, 0xb6, 0x36, 0xf6,
-	0x34, 0x89, 0x70, 0x6c, 0xb3, 0xde, 0x84, 0xf2, 0x86, 0x3d, 0xf2, 0x08, 0xc0, 0x2b, 0xf0, 0x02,
-	0x78, 0x67, 0xed, 0x75, 0xdc, 0x6c, 0x0e, 0x96, 0xec, 0x99, 0x6f, 0x7e, 0xf3, 0xed, 0x7a, 0x34,
-	0xa4, 0x2b, 0xf8, 0xa5, 0x74, 0x17, 0xa1, 0x04, 0x11, 0xf2, 0x60, 0x10, 0x8b, 0x48, 0x46, 0xb4,
-	0x01, 0xd2, 0xf3, 0x13, 0x10, 0x6b, 0x10, 0xf1, 0xb4, 0x77, 0x77, 0x16, 0xcd, 0x22, 0x4c, 0xbc,
-	0x54, 0x6f, 0x5a, 0xd3, 0xeb, 0x14, 0x9a, 0x2c, 0x52, 0x13, 0xb1, 0xa7, 0x5f, 0xfb, 0xaf, 0x49,
-	0xd3, 0x81, 0xef, 0x2b, 0x48, 0xe4, 0x07, 0xe0, 0x3e, 0x08, 0xda, 0x22, 0x95, 0xf3, 0x31, 0xdb,
-	0x3b, 0xd9, 0x7b, 0x76, 0xe0, 0x54, 0x16, 0x63, 0xda, 0x23, 0x47, 0xab, 0x44, 0xb5, 0x5c, 0x02,
-	0xab, 0xa4, 0xd1, 0x9a, 0x63, 0xbe, 0xfb, 0xff, 0x6a, 0xa4, 0x7b, 0x9e, 0x19, 0x72, 0x52, 0x77,
-	0x19, 0x69, 0x8b, 0xf1, 0x94, 0x54, 0xd6, 0xa7, 0x58, 0x5d, 0x3f, 0xbd, 0x37, 0xd8, 0xb4, 0x3c,
-	0xc8, 0x4a, 0x9c, 0x54, 0x40, 0x5f, 0x91, 0x43, 0xc1, 0xc3, 0x19, 0xb0, 0x7d, 0x54, 0xf6, 0x6e,
-	0x28, 0x55, 0x2a, 0x97, 0x6b, 0x21, 0x7d, 0x4e, 0xf6, 0xe3, 0x95, 0x64, 0x07, 0xa8, 0x67, 0x65,
-	0xfd, 0xc5, 0x2a, 0xf7, 0xe3, 0x28, 0x11, 0x1d, 0x91, 0x86, 0x0f, 0x01, 0x48, 0x70, 0x75, 0x93,
-	0x43, 0x2c, 0x3a, 0x29, 0x17, 0x8d, 0x51, 0x51, 0x6a, 0x55, 0xf7, 0x8b, 0x98, 0x6a, 0x28, 0xaf,
-	0x42, 0x56, 0xb5, 0x35, 0xfc, 0x72, 0x15, 0x9a, 0x86, 0xa9, 0x88, 0xbe, 0x21, 0xc4, 0x8b, 0x96,
-	0x31, 0xf7, 0xe4, 0x22, 0x0a, 0xd9, 0x6d, 0x2c, 0x79, 0x54, 0x2e, 0x19, 0x99, 0x7c, 0x5e, 0xb9,
-	0x51, 0x42, 0xdf, 0x92, 0x7a, 0x00, 0x3c, 0x01, 0x77, 0x96, 0x3a, 0x96, 0xec, 0xc8, 0x46, 0xf8,
-	0xa4, 0x04, 0xef, 0x55, 0xde, 0x10, 0x02, 0x13, 0x52, 0x67, 0xd6, 0x04, 0x01, 0xeb, 0xe8, 0x1b,
-	0xb0, 0x9a, 0xed, 0xcc, 0x88, 0x70, 0x50, 0x60, 0xce, 0x1c, 0x14, 0x31, 0x65, 0x83, 0xaf, 0xe4,
-	0xdc, 0x85, 0x90, 0x4f, 0x03, 0x60, 0xc4, 0x66, 0x63, 0x98, 0x0a, 0x26, 0x98, 0x37, 0x36, 0xb8,
-	0x09, 0x29, 0x1b, 0x48, 0xf0, 0x17, 0x09, 0x22, 0xea, 0x36, 0x1b, 0x0a, 0x31, 0xd6, 0x02, 0x63,
-	0x83, 0x17, 0x31, 0x3a, 0x26, 0x4d, 0x84, 0xa8, 0xe9, 0x73, 0xb9, 0xef, 0xb3, 0xc6, 0x2e, 0xca,
-	0xd7, 0xf4, 0x6b, 0xe8, 0xfb, 0x25, 0x4a, 0x16, 0xa3, 0x9f, 0x49, 0xa7, 0xa0, 0xe8, 0x3f, 0xcb,
-	0x9a, 0x08, 0x7a, 0x62, 0x07, 0x65, 0x13, 0x91, 0xb1, 0x5a, 0xbc, 0x14, 0xa6, 0x97, 0xe4, 0x41,
-	0x81, 0xf3, 0xe6, 0x6a, 0x46, 0xdc, 0x98, 0x27, 0xc9, 0x8f, 0x48, 0xf8, 0xac, 0x85, 0xdc, 0x17,
-	0x76, 0xee, 0x08, 0xc5, 0x17, 0x99, 0x36, 0xe7, 0xdf, 0xe7, 0xd6, 0x34, 0xfd, 0x48, 0xda, 0x45,
-	0x1f, 0x3d, 0x0e, 0x6d, 0xa4, 0xf7, 0xed, 0xf4, 0xd2, 0x44, 0x34, 0xf9, 0x66, 0xd4, 0x5c, 0xa4,
-	0x88, 0x02, 0xc0, 0x8b, 0xec, 0xec, 0xba, 0x48, 0x27, 0x55, 0xdc, 0xbc, 0xc8, 0x2c, 0x66, 0x1c,
-	0x21, 0x45, 0x3b, 0xba, 0xb3, 0xcb, 0x91, 0xaa, 0xd9, 0x76, 0x64, 0xa2, 0x74, 0xa2, 0xe7, 0x03,
-	0x42, 0xb9, 0xf0, 0x78, 0xfa, 0x43, 0x28, 0x82, 0x1e, 0x6f, 0x83, 0x72, 0x45, 0xce, 0x29, 0x95,
-	0xa9, 0xfd, 0xc1, 0x03, 0x2e, 0x96, 0xac, 0x6b, 0xdb, 0x1f, 0x43, 0x95, 0x32, 0xfb, 0x03, 0x85,
-	0xf4, 0x8c, 0x54, 0xe7, 0xb8, 0xf6, 0x98, 0x8f, 0x25, 0x0f, 0xad, 0xcb, 0x49, 0x6f, 0x46, 0x27,
-	0x93, 0xf6, 0xdb, 0xa4, 0x39, 0x59, 0xc6, 0xf2, 0xa7, 0x03, 0x49, 0x1c, 0x85, 0x09, 0xbc, 0xeb,
-	0x5c, 0xff, 0x39, 0xbe, 0x75, 0xfd, 0xf7, 0x78, 0xef, 0x57, 0xfa, 0xfc, 0x4e, 0x9f, 0x69, 0x15,
-	0x97, 0xeb, 0xd9, 0xff, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb1, 0xad, 0x4e, 0x86, 0xb4, 0x05, 0x00,
-	0x00,
+	// 630 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x7d, 0x95, 0xdf, 0x6e, 0xd3, 0x30,
+	0x14, 0xc6, 0x69, 0xb7, 0x95, 0xd5, 0xfd, 0x8b, 0xbb, 0x4d, 0xa6, 0x48, 0x63, 0x74, 0x42, 0x42,
+	0x20, 0x15, 0xb4, 0x5d, 0x72, 0x01, 0xa5, 0xad, 0x60, 0x08, 0xa4, 0x29, 0x82, 0xeb, 0xc8, 0x4d,
+	0xbc, 0xb6, 0x22, 0x4d, 0x82, 0xe3, 0x96, 0xf1, 0x86, 0xbb, 0xe4, 0x11, 0x06, 0x4f, 0x42, 0x7c,
+	0xe2, 0x38, 0xf5, 0xea, 0xee, 0x22, 0x52, 0xfa, 0xf9, 0x3b, 0xbf, 0xf3, 0xc5, 0x39, 0x71, 0x51,
+	0x87, 0xd3, 0x2b, 0xe1, 0xce, 0x43, 0xc1, 0x78, 0x48, 0x83, 0x7e, 0xcc, 0x23, 0x11, 0xe1, 0x3a,
+	0x13, 0x9e, 0x9f, 0x30, 0xbe, 0x62, 0x3c, 0x9e, 0x74, 0x0f, 0xa6, 0xd1, 0x34, 0x82, 0x85, 0xd7,
+	0xf2, 0x2e, 0xf3, 0x74, 0xdb, 0x85, 0x47, 0x29, 0x55, 0x1e, 0x7b, 0xd9, 0x6d, 0xef, 0x2d, 0x6a,
+	0x38, 0xec, 0xe7, 0x92, 0x25, 0xe2, 0x13, 0xa3, 0x3e, 0xe3, 0xb8, 0x89, 0xca, 0x17, 0x23, 0x52,
+	0x3a, 0x29, 0xbd, 0xd8, 0x75, 0xca, 0xf3, 0x11, 0xee, 0xa2, 0xfd, 0x65, 0x22, 0x5b, 0x2e, 0x18,
+	0x29, 0xa7, 0x6a, 0xd5, 0xd1, 0xbf, 0x7b, 0xb7, 0x35, 0xd4, 0xb9, 0x50, 0x81, 0x9c, 0x34, 0x9d,
+	0x22, 0x6d, 0x30, 0x9e, 0xa3, 0xf2, 0xea, 0x0c, 0xaa, 0x6b, 0x67, 0x87, 0xfd, 0xf5, 0xc8, 0x7d,
+	0x55, 0xe2, 0xa4, 0x06, 0xfc, 0x06, 0xed, 0x71, 0x1a, 0x4e, 0x19, 0xd9, 0x01, 0x67, 0xf7, 0x8e,
+	0x53, 0x2e, 0xe5, 0xf6, 0xcc, 0x88, 0x5f, 0xa2, 0x9d, 0x78, 0x29, 0xc8, 0x2e, 0xf8, 0x89, 0xe9,
+	0xbf, 0x5c, 0xe6, 0x79, 0x1c, 0x69, 0xc2, 0x43, 0x54, 0xf7, 0x59, 0xc0, 0x04, 0x73, 0xb3, 0x26,
+	0x7b, 0x50, 0x74, 0x62, 0x16, 0x8d, 0xc0, 0x61, 0xb4, 0xaa, 0xf9, 0x85, 0x26, 0x1b, 0x8a, 0xeb,
+	0x90, 0x54, 0x6c, 0x0d, 0xbf, 0x5d, 0x87, 0xba, 0x61, 0x6a, 0xc2, 0xef, 0x10, 0xf2, 0xa2, 0x45,
+	0x4c, 0x3d, 0x31, 0x8f, 0x42, 0xf2, 0x10, 0x4a, 0x9e, 0x9a, 0x25, 0x43, 0xbd, 0x9e, 0x57, 0xae,
+	0x95, 0xe0, 0xf7, 0xa8, 0x16, 0x30, 0x9a, 0x30, 0x77, 0x9a, 0x26, 0x16, 0x64, 0xdf, 0x46, 0xf8,
+	0x22, 0x0d, 0x1f, 0xe5, 0xba, 0x26, 0x04, 0x5a, 0x92, 0xcf, 0x9c, 0x11, 0x38, 0x5b, 0x45, 0x3f,
+	0x18, 0xa9, 0xda, 0x9e, 0x19, 0x10, 0x0e, 0x18, 0xf4, 0x33, 0x07, 0x85, 0x26, 0x63, 0xd0, 0xa5,
+	0x98, 0xb9, 0x2c, 0xa4, 0x93, 0x80, 0x11, 0x64, 0x8b, 0x31, 0x48, 0x0d, 0x63, 0x58, 0xd7, 0x31,
+	0xa8, 0x96, 0x64, 0x0c, 0x20, 0xf8, 0xf3, 0x04, 0x10, 0x35, 0x5b, 0x0c, 0x89, 0x18, 0x65, 0x06,
+	0x1d, 0x83, 0x16, 0x1a, 0x1e, 0xa1, 0x06, 0x40, 0xe4, 0xf4, 0xb9, 0xd4, 0xf7, 0x49, 0x7d, 0x1b,
+	0xe5, 0x7b, 0xfa, 0x6b, 0xe0, 0xfb, 0x06, 0x45, 0x69, 0xf8, 0x2b, 0x6a, 0x17, 0x94, 0xec, 0xcd,
+	0x92, 0x06, 0x80, 0x4e, 0xed, 0x20, 0x35, 0x11, 0x8a, 0xd5, 0xa4, 0x86, 0x8c, 0xaf, 0xd0, 0xe3,
+	0x02, 0xe7, 0xcd, 0xe4, 0x8c, 0xb8, 0x31, 0x4d, 0x92, 0x5f, 0x11, 0xf7, 0x49, 0x13, 0xb8, 0xaf,
+	0xec, 0xdc, 0x21, 0x98, 0x2f, 0x95, 0x37, 0xe7, 0x1f, 0x51, 0xeb, 0x32, 0xfe, 0x8c, 0x5a, 0x45,
+	0x9f, 0x6c, 0x1c, 0x5a, 0x40, 0xef, 0xd9, 0xe9, 0xc6, 0x44, 0x34, 0xe8, 0xba, 0xaa, 0x37, 0x92,
+	0x47, 0x01, 0x83, 0x8d, 0x6c, 0x6f, 0xdb, 0x48, 0x27, 0x75, 0xdc, 0xdd, 0x48, 0xa5, 0xe9, 0x44,
+	0x40, 0xc9, 0x12, 0x3d, 0xda, 0x96, 0x48, 0xd6, 0x6c, 0x26, 0xd2, 0x2a, 0x1e, 0x67, 0xf3, 0xc1,
+	0x42, 0x31, 0xf7, 0x68, 0xfa, 0x42, 0x30, 0x80, 0x9e, 0x6d, 0x82, 0x72, 0x47, 0xce, 0x31, 0xca,
+	0xe4, 0xf9, 0x41, 0x03, 0xca, 0x17, 0xa4, 0x63, 0x3b, 0x3f, 0x06, 0x72, 0x49, 0x9f, 0x1f, 0x60,
+	0xc4, 0xe7, 0xa8, 0x32, 0x83, 0x63, 0x8f, 0xf8, 0x50, 0xf2, 0xc4, 0x7a, 0x38, 0x65, 0x27, 0xa3,
+	0xa3, 0xac, 0xe6, 0x08, 0xa9, 0x0f, 0xeb, 0xe0, 0xbe, 0x11, 0x32, 0xbf, 0x2d, 0x3d, 0x42, 0xea,
+	0xf3, 0xca, 0x71, 0xb0, 0x91, 0x6a, 0x22, 0x0f, 0xb7, 0xe1, 0xe4, 0x9e, 0x59, 0x26, 0xb2, 0x90,
+	0x4d, 0x9c, 0x4a, 0x77, 0x74, 0x1f, 0xce, 0x92, 0xae, 0x90, 0x7b, 0x2d, 0xd4, 0x18, 0x2f, 0x62,
+	0xf1, 0xdb, 0x61, 0x49, 0x1c, 0x85, 0x09, 0xfb, 0xd0, 0xbe, 0xf9, 0x7b, 0xfc, 0xe0, 0xe6, 0xdf,
+	0x71, 0xe9, 0x4f, 0x7a, 0xdd, 0xa6, 0xd7, 0xa4, 0x02, 0xff, 0x24, 0xe7, 0xff, 0x01, 0x3a, 0x72,
+	0x83, 0x6c, 0xa1, 0x06, 0x00, 0x00,
 }
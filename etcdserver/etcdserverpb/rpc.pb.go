@@ -106,20 +106,23 @@ func (RangeRequest_SortTarget) EnumDescriptor() ([]byte, []int) { return fileDes
 type Compare_CompareResult int32
 
 const (
-	Compare_EQUAL   Compare_CompareResult = 0
-	Compare_GREATER Compare_CompareResult = 1
-	Compare_LESS    Compare_CompareResult = 2
+	Compare_EQUAL     Compare_CompareResult = 0
+	Compare_GREATER   Compare_CompareResult = 1
+	Compare_LESS      Compare_CompareResult = 2
+	Compare_NOT_EQUAL Compare_CompareResult = 3
 )
 
 var Compare_CompareResult_name = map[int32]string{
 	0: "EQUAL",
 	1: "GREATER",
 	2: "LESS",
+	3: "NOT_EQUAL",
 }
 var Compare_CompareResult_value = map[string]int32{
-	"EQUAL":   0,
-	"GREATER": 1,
-	"LESS":    2,
+	"EQUAL":     0,
+	"GREATER":   1,
+	"LESS":      2,
+	"NOT_EQUAL": 3,
 }
 
 func (x Compare_CompareResult) String() string {
@@ -154,6 +157,31 @@ func (x Compare_CompareTarget) String() string {
 }
 func (Compare_CompareTarget) EnumDescriptor() ([]byte, []int) { return fileDescriptorRpc, []int{9, 1} }
 
+type WatchCreateRequest_FilterType int32
+
+const (
+	// filter out put event.
+	WatchCreateRequest_NOPUT WatchCreateRequest_FilterType = 0
+	// filter out delete event.
+	WatchCreateRequest_NODELETE WatchCreateRequest_FilterType = 1
+)
+
+var WatchCreateRequest_FilterType_name = map[int32]string{
+	0: "NOPUT",
+	1: "NODELETE",
+}
+var WatchCreateRequest_FilterType_value = map[string]int32{
+	"NOPUT":    0,
+	"NODELETE": 1,
+}
+
+func (x WatchCreateRequest_FilterType) String() string {
+	return proto.EnumName(WatchCreateRequest_FilterType_name, int32(x))
+}
+func (WatchCreateRequest_FilterType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptorRpc, []int{19, 0}
+}
+
 type AlarmRequest_AlarmAction int32
 
 const (
@@ -177,7 +205,7 @@ func (x AlarmRequest_AlarmAction) String() string {
 	return proto.EnumName(AlarmRequest_AlarmAction_name, int32(x))
 }
 func (AlarmRequest_AlarmAction) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptorRpc, []int{39, 0}
+	return fileDescriptorRpc, []int{47, 0}
 }
 
 type ResponseHeader struct {
@@ -222,6 +250,22 @@ type RangeRequest struct {
 	// a serializable range request is served locally without needing to reach consensus
 	// with other nodes in the cluster.
 	Serializable bool `protobuf:"varint,7,opt,name=serializable,proto3" json:"serializable,omitempty"`
+	// count_only when set returns only the count of the keys in the range.
+	CountOnly bool `protobuf:"varint,8,opt,name=count_only,json=countOnly,proto3" json:"count_only,omitempty"`
+	// keys_only when set returns only the keys and not the values.
+	KeysOnly bool `protobuf:"varint,9,opt,name=keys_only,json=keysOnly,proto3" json:"keys_only,omitempty"`
+	// min_create_revision is the lower bound for returned key create revisions; all keys with
+	// lesser create revisions will be filtered away.
+	MinCreateRevision int64 `protobuf:"varint,10,opt,name=min_create_revision,json=minCreateRevision,proto3" json:"min_create_revision,omitempty"`
+	// max_create_revision is the upper bound for returned key create revisions; all keys with
+	// greater create revisions will be filtered away.
+	MaxCreateRevision int64 `protobuf:"varint,11,opt,name=max_create_revision,json=maxCreateRevision,proto3" json:"max_create_revision,omitempty"`
+	// min_mod_revision is the lower bound for returned key mod revisions; all keys with
+	// lesser mod revisions will be filtered away.
+	MinModRevision int64 `protobuf:"varint,12,opt,name=min_mod_revision,json=minModRevision,proto3" json:"min_mod_revision,omitempty"`
+	// max_mod_revision is the upper bound for returned key mod revisions; all keys with
+	// greater mod revisions will be filtered away.
+	MaxModRevision int64 `protobuf:"varint,13,opt,name=max_mod_revision,json=maxModRevision,proto3" json:"max_mod_revision,omitempty"`
 }
 
 func (m *RangeRequest) Reset()                    { *m = RangeRequest{} }
@@ -235,6 +279,8 @@ type RangeResponse struct {
 	Kvs []*mvccpb.KeyValue `protobuf:"bytes,2,rep,name=kvs" json:"kvs,omitempty"`
 	// more indicates if there are more keys to return in the requested range.
 	More bool `protobuf:"varint,3,opt,name=more,proto3" json:"more,omitempty"`
+	// count is set to the number of keys within the range when requested.
+	Count int64 `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
 }
 
 func (m *RangeResponse) Reset()                    { *m = RangeResponse{} }
@@ -264,6 +310,15 @@ type PutRequest struct {
 	// lease is the lease ID to associate with the key in the key-value store. A lease
 	// value of 0 indicates no lease.
 	Lease int64 `protobuf:"varint,3,opt,name=lease,proto3" json:"lease,omitempty"`
+	// If ignore_value is set, etcd updates the key using its current value.
+	// Returns an error if the key does not exist.
+	IgnoreValue bool `protobuf:"varint,4,opt,name=ignore_value,json=ignoreValue,proto3" json:"ignore_value,omitempty"`
+	// If ignore_lease is set, etcd updates the key using its current lease.
+	// Returns an error if the key does not exist.
+	IgnoreLease bool `protobuf:"varint,5,opt,name=ignore_lease,json=ignoreLease,proto3" json:"ignore_lease,omitempty"`
+	// If prev_kv is set, etcd gets the previous key-value pair before changing it.
+	// The previous key-value pair will be returned in the put response.
+	PrevKv bool `protobuf:"varint,6,opt,name=prev_kv,json=prevKv,proto3" json:"prev_kv,omitempty"`
 }
 
 func (m *PutRequest) Reset()                    { *m = PutRequest{} }
@@ -273,6 +328,8 @@ func (*PutRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []in
 
 type PutResponse struct {
 	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+	// if prev_kv is set in the request, the previous key-value pair will be returned.
+	PrevKv *mvccpb.KeyValue `protobuf:"bytes,2,opt,name=prev_kv,json=prevKv" json:"prev_kv,omitempty"`
 }
 
 func (m *PutResponse) Reset()                    { *m = PutResponse{} }
@@ -287,6 +344,13 @@ func (m *PutResponse) GetHeader() *ResponseHeader {
 	return nil
 }
 
+func (m *PutResponse) GetPrevKv() *mvccpb.KeyValue {
+	if m != nil {
+		return m.PrevKv
+	}
+	return nil
+}
+
 type DeleteRangeRequest struct {
 	// key is the first key to delete in the range.
 	Key []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
@@ -294,6 +358,9 @@ type DeleteRangeRequest struct {
 	// If range_end is not given, the range is defined to contain only the key argument.
 	// If range_end is '\0', the range is all keys greater than or equal to the key argument.
 	RangeEnd []byte `protobuf:"bytes,2,opt,name=range_end,json=rangeEnd,proto3" json:"range_end,omitempty"`
+	// If prev_kv is set, etcd gets the previous key-value pairs before deleting it.
+	// The previous key-value pairs will be returned in the delete response.
+	PrevKv bool `protobuf:"varint,3,opt,name=prev_kv,json=prevKv,proto3" json:"prev_kv,omitempty"`
 }
 
 func (m *DeleteRangeRequest) Reset()                    { *m = DeleteRangeRequest{} }
@@ -305,6 +372,8 @@ type DeleteRangeResponse struct {
 	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
 	// deleted is the number of keys deleted by the delete range request.
 	Deleted int64 `protobuf:"varint,2,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	// if prev_kv is set in the request, the previous key-value pairs will be returned.
+	PrevKvs []*mvccpb.KeyValue `protobuf:"bytes,3,rep,name=prev_kvs,json=prevKvs" json:"prev_kvs,omitempty"`
 }
 
 func (m *DeleteRangeResponse) Reset()                    { *m = DeleteRangeResponse{} }
@@ -319,6 +388,13 @@ func (m *DeleteRangeResponse) GetHeader() *ResponseHeader {
 	return nil
 }
 
+func (m *DeleteRangeResponse) GetPrevKvs() []*mvccpb.KeyValue {
+	if m != nil {
+		return m.PrevKvs
+	}
+	return nil
+}
+
 type RequestUnion struct {
 	// request is a union of request types accepted by a transaction.
 	//
@@ -979,6 +1055,7 @@ type WatchRequest struct {
 	// Types that are valid to be assigned to RequestUnion:
 	//	*WatchRequest_CreateRequest
 	//	*WatchRequest_CancelRequest
+	//	*WatchRequest_ProgressRequest
 	RequestUnion isWatchRequest_RequestUnion `protobuf_oneof:"request_union"`
 }
 
@@ -999,9 +1076,13 @@ type WatchRequest_CreateRequest struct {
 type WatchRequest_CancelRequest struct {
 	CancelRequest *WatchCancelRequest `protobuf:"bytes,2,opt,name=cancel_request,json=cancelRequest,oneof"`
 }
+type WatchRequest_ProgressRequest struct {
+	ProgressRequest *WatchProgressRequest `protobuf:"bytes,3,opt,name=progress_request,json=progressRequest,oneof"`
+}
 
-func (*WatchRequest_CreateRequest) isWatchRequest_RequestUnion() {}
-func (*WatchRequest_CancelRequest) isWatchRequest_RequestUnion() {}
+func (*WatchRequest_CreateRequest) isWatchRequest_RequestUnion()   {}
+func (*WatchRequest_CancelRequest) isWatchRequest_RequestUnion()   {}
+func (*WatchRequest_ProgressRequest) isWatchRequest_RequestUnion() {}
 
 func (m *WatchRequest) GetRequestUnion() isWatchRequest_RequestUnion {
 	if m != nil {
@@ -1024,11 +1105,19 @@ func (m *WatchRequest) GetCancelRequest() *WatchCancelRequest {
 	return nil
 }
 
+func (m *WatchRequest) GetProgressRequest() *WatchProgressRequest {
+	if x, ok := m.GetRequestUnion().(*WatchRequest_ProgressRequest); ok {
+		return x.ProgressRequest
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*WatchRequest) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _WatchRequest_OneofMarshaler, _WatchRequest_OneofUnmarshaler, _WatchRequest_OneofSizer, []interface{}{
 		(*WatchRequest_CreateRequest)(nil),
 		(*WatchRequest_CancelRequest)(nil),
+		(*WatchRequest_ProgressRequest)(nil),
 	}
 }
 
@@ -1046,6 +1135,11 @@ func _WatchRequest_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.CancelRequest); err != nil {
 			return err
 		}
+	case *WatchRequest_ProgressRequest:
+		_ = b.EncodeVarint(3<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ProgressRequest); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("WatchRequest.RequestUnion has unexpected type %T", x)
@@ -1072,6 +1166,14 @@ func _WatchRequest_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.B
 		err := b.DecodeMessage(msg)
 		m.RequestUnion = &WatchRequest_CancelRequest{msg}
 		return true, err
+	case 3: // request_union.progress_request
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(WatchProgressRequest)
+		err := b.DecodeMessage(msg)
+		m.RequestUnion = &WatchRequest_ProgressRequest{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -1091,6 +1193,11 @@ func _WatchRequest_OneofSizer(msg proto.Message) (n int) {
 		n += proto.SizeVarint(2<<3 | proto.WireBytes)
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *WatchRequest_ProgressRequest:
+		s := proto.Size(x.ProgressRequest)
+		n += proto.SizeVarint(3<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -1112,6 +1219,11 @@ type WatchCreateRequest struct {
 	// wish to recover a disconnected watcher starting from a recent known revision.
 	// The etcd server may decide how often it will send notifications based on current load.
 	ProgressNotify bool `protobuf:"varint,4,opt,name=progress_notify,json=progressNotify,proto3" json:"progress_notify,omitempty"`
+	// filters filter the events at server side before it sends back to the watcher.
+	Filters []WatchCreateRequest_FilterType `protobuf:"varint,5,rep,packed,name=filters,enum=etcdserverpb.WatchCreateRequest_FilterType" json:"filters,omitempty"`
+	// If prev_kv is set, created watcher gets the previous KV before the event happens.
+	// If the previous KV is already compacted, nothing will be returned.
+	PrevKv bool `protobuf:"varint,6,opt,name=prev_kv,json=prevKv,proto3" json:"prev_kv,omitempty"`
 }
 
 func (m *WatchCreateRequest) Reset()                    { *m = WatchCreateRequest{} }
@@ -1129,6 +1241,16 @@ func (m *WatchCancelRequest) String() string            { return proto.CompactTe
 func (*WatchCancelRequest) ProtoMessage()               {}
 func (*WatchCancelRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{20} }
 
+// WatchProgressRequest requests that a progress notification be sent to every
+// watcher on the stream that is synced with the current revision.
+type WatchProgressRequest struct {
+}
+
+func (m *WatchProgressRequest) Reset()                    { *m = WatchProgressRequest{} }
+func (m *WatchProgressRequest) String() string            { return proto.CompactTextString(m) }
+func (*WatchProgressRequest) ProtoMessage()               {}
+func (*WatchProgressRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{21} }
+
 type WatchResponse struct {
 	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
 	// watch_id is the ID of the watcher that corresponds to the response.
@@ -1156,7 +1278,7 @@ type WatchResponse struct {
 func (m *WatchResponse) Reset()                    { *m = WatchResponse{} }
 func (m *WatchResponse) String() string            { return proto.CompactTextString(m) }
 func (*WatchResponse) ProtoMessage()               {}
-func (*WatchResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{21} }
+func (*WatchResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{22} }
 
 func (m *WatchResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -1182,7 +1304,7 @@ type LeaseGrantRequest struct {
 func (m *LeaseGrantRequest) Reset()                    { *m = LeaseGrantRequest{} }
 func (m *LeaseGrantRequest) String() string            { return proto.CompactTextString(m) }
 func (*LeaseGrantRequest) ProtoMessage()               {}
-func (*LeaseGrantRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{22} }
+func (*LeaseGrantRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{23} }
 
 type LeaseGrantResponse struct {
 	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
@@ -1196,7 +1318,7 @@ type LeaseGrantResponse struct {
 func (m *LeaseGrantResponse) Reset()                    { *m = LeaseGrantResponse{} }
 func (m *LeaseGrantResponse) String() string            { return proto.CompactTextString(m) }
 func (*LeaseGrantResponse) ProtoMessage()               {}
-func (*LeaseGrantResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{23} }
+func (*LeaseGrantResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{24} }
 
 func (m *LeaseGrantResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -1213,7 +1335,7 @@ type LeaseRevokeRequest struct {
 func (m *LeaseRevokeRequest) Reset()                    { *m = LeaseRevokeRequest{} }
 func (m *LeaseRevokeRequest) String() string            { return proto.CompactTextString(m) }
 func (*LeaseRevokeRequest) ProtoMessage()               {}
-func (*LeaseRevokeRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{24} }
+func (*LeaseRevokeRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{25} }
 
 type LeaseRevokeResponse struct {
 	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
@@ -1222,7 +1344,7 @@ type LeaseRevokeResponse struct {
 func (m *LeaseRevokeResponse) Reset()                    { *m = LeaseRevokeResponse{} }
 func (m *LeaseRevokeResponse) String() string            { return proto.CompactTextString(m) }
 func (*LeaseRevokeResponse) ProtoMessage()               {}
-func (*LeaseRevokeResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{25} }
+func (*LeaseRevokeResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{26} }
 
 func (m *LeaseRevokeResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -1239,7 +1361,7 @@ type LeaseKeepAliveRequest struct {
 func (m *LeaseKeepAliveRequest) Reset()                    { *m = LeaseKeepAliveRequest{} }
 func (m *LeaseKeepAliveRequest) String() string            { return proto.CompactTextString(m) }
 func (*LeaseKeepAliveRequest) ProtoMessage()               {}
-func (*LeaseKeepAliveRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{26} }
+func (*LeaseKeepAliveRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{27} }
 
 type LeaseKeepAliveResponse struct {
 	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
@@ -1252,7 +1374,7 @@ type LeaseKeepAliveResponse struct {
 func (m *LeaseKeepAliveResponse) Reset()                    { *m = LeaseKeepAliveResponse{} }
 func (m *LeaseKeepAliveResponse) String() string            { return proto.CompactTextString(m) }
 func (*LeaseKeepAliveResponse) ProtoMessage()               {}
-func (*LeaseKeepAliveResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{27} }
+func (*LeaseKeepAliveResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{28} }
 
 func (m *LeaseKeepAliveResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -1261,6 +1383,84 @@ func (m *LeaseKeepAliveResponse) GetHeader() *ResponseHeader {
 	return nil
 }
 
+type LeaseTimeToLiveRequest struct {
+	// ID is the lease ID for the lease.
+	ID int64 `protobuf:"varint,1,opt,name=ID,json=iD,proto3" json:"ID,omitempty"`
+	// keys is true to query all the keys attached to this lease.
+	Keys bool `protobuf:"varint,2,opt,name=keys,proto3" json:"keys,omitempty"`
+}
+
+func (m *LeaseTimeToLiveRequest) Reset()                    { *m = LeaseTimeToLiveRequest{} }
+func (m *LeaseTimeToLiveRequest) String() string            { return proto.CompactTextString(m) }
+func (*LeaseTimeToLiveRequest) ProtoMessage()               {}
+func (*LeaseTimeToLiveRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{29} }
+
+type LeaseTimeToLiveResponse struct {
+	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+	// ID is the lease ID from the time to live request.
+	ID int64 `protobuf:"varint,2,opt,name=ID,json=iD,proto3" json:"ID,omitempty"`
+	// TTL is the remaining TTL in seconds for the lease; the lease will expire in under TTL+1 seconds.
+	// An expired or unknown lease has a TTL of -1.
+	TTL int64 `protobuf:"varint,3,opt,name=TTL,json=tTL,proto3" json:"TTL,omitempty"`
+	// GrantedTTL is the initial granted time in seconds upon lease creation/renewal.
+	GrantedTTL int64 `protobuf:"varint,4,opt,name=grantedTTL,proto3" json:"grantedTTL,omitempty"`
+	// Keys is the list of keys attached to this lease.
+	Keys [][]byte `protobuf:"bytes,5,rep,name=keys" json:"keys,omitempty"`
+}
+
+func (m *LeaseTimeToLiveResponse) Reset()                    { *m = LeaseTimeToLiveResponse{} }
+func (m *LeaseTimeToLiveResponse) String() string            { return proto.CompactTextString(m) }
+func (*LeaseTimeToLiveResponse) ProtoMessage()               {}
+func (*LeaseTimeToLiveResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{30} }
+
+func (m *LeaseTimeToLiveResponse) GetHeader() *ResponseHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+type LeaseLeasesRequest struct {
+}
+
+func (m *LeaseLeasesRequest) Reset()                    { *m = LeaseLeasesRequest{} }
+func (m *LeaseLeasesRequest) String() string            { return proto.CompactTextString(m) }
+func (*LeaseLeasesRequest) ProtoMessage()               {}
+func (*LeaseLeasesRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{31} }
+
+type LeaseStatus struct {
+	ID int64 `protobuf:"varint,1,opt,name=ID,json=iD,proto3" json:"ID,omitempty"`
+}
+
+func (m *LeaseStatus) Reset()                    { *m = LeaseStatus{} }
+func (m *LeaseStatus) String() string            { return proto.CompactTextString(m) }
+func (*LeaseStatus) ProtoMessage()               {}
+func (*LeaseStatus) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{32} }
+
+type LeaseLeasesResponse struct {
+	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+	Leases []*LeaseStatus  `protobuf:"bytes,2,rep,name=leases" json:"leases,omitempty"`
+}
+
+func (m *LeaseLeasesResponse) Reset()                    { *m = LeaseLeasesResponse{} }
+func (m *LeaseLeasesResponse) String() string            { return proto.CompactTextString(m) }
+func (*LeaseLeasesResponse) ProtoMessage()               {}
+func (*LeaseLeasesResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{33} }
+
+func (m *LeaseLeasesResponse) GetHeader() *ResponseHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *LeaseLeasesResponse) GetLeases() []*LeaseStatus {
+	if m != nil {
+		return m.Leases
+	}
+	return nil
+}
+
 type Member struct {
 	// ID is the member ID for this member.
 	ID uint64 `protobuf:"varint,1,opt,name=ID,json=iD,proto3" json:"ID,omitempty"`
@@ -1275,7 +1475,7 @@ type Member struct {
 func (m *Member) Reset()                    { *m = Member{} }
 func (m *Member) String() string            { return proto.CompactTextString(m) }
 func (*Member) ProtoMessage()               {}
-func (*Member) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{28} }
+func (*Member) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{34} }
 
 type MemberAddRequest struct {
 	// peerURLs is the list of URLs the added member will use to communicate with the cluster.
@@ -1285,18 +1485,20 @@ type MemberAddRequest struct {
 func (m *MemberAddRequest) Reset()                    { *m = MemberAddRequest{} }
 func (m *MemberAddRequest) String() string            { return proto.CompactTextString(m) }
 func (*MemberAddRequest) ProtoMessage()               {}
-func (*MemberAddRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{29} }
+func (*MemberAddRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{35} }
 
 type MemberAddResponse struct {
 	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
 	// member is the member information for the added member.
 	Member *Member `protobuf:"bytes,2,opt,name=member" json:"member,omitempty"`
+	// members is a list of all members after adding the member.
+	Members []*Member `protobuf:"bytes,3,rep,name=members" json:"members,omitempty"`
 }
 
 func (m *MemberAddResponse) Reset()                    { *m = MemberAddResponse{} }
 func (m *MemberAddResponse) String() string            { return proto.CompactTextString(m) }
 func (*MemberAddResponse) ProtoMessage()               {}
-func (*MemberAddResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{30} }
+func (*MemberAddResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{36} }
 
 func (m *MemberAddResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -1312,6 +1514,13 @@ func (m *MemberAddResponse) GetMember() *Member {
 	return nil
 }
 
+func (m *MemberAddResponse) GetMembers() []*Member {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
 type MemberRemoveRequest struct {
 	// ID is the member ID of the member to remove.
 	ID uint64 `protobuf:"varint,1,opt,name=ID,json=iD,proto3" json:"ID,omitempty"`
@@ -1320,16 +1529,18 @@ type MemberRemoveRequest struct {
 func (m *MemberRemoveRequest) Reset()                    { *m = MemberRemoveRequest{} }
 func (m *MemberRemoveRequest) String() string            { return proto.CompactTextString(m) }
 func (*MemberRemoveRequest) ProtoMessage()               {}
-func (*MemberRemoveRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{31} }
+func (*MemberRemoveRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{37} }
 
 type MemberRemoveResponse struct {
 	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+	// members is a list of all members after removing the member.
+	Members []*Member `protobuf:"bytes,2,rep,name=members" json:"members,omitempty"`
 }
 
 func (m *MemberRemoveResponse) Reset()                    { *m = MemberRemoveResponse{} }
 func (m *MemberRemoveResponse) String() string            { return proto.CompactTextString(m) }
 func (*MemberRemoveResponse) ProtoMessage()               {}
-func (*MemberRemoveResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{32} }
+func (*MemberRemoveResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{38} }
 
 func (m *MemberRemoveResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -1338,6 +1549,13 @@ func (m *MemberRemoveResponse) GetHeader() *ResponseHeader {
 	return nil
 }
 
+func (m *MemberRemoveResponse) GetMembers() []*Member {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
 type MemberUpdateRequest struct {
 	// ID is the member ID of the member to update.
 	ID uint64 `protobuf:"varint,1,opt,name=ID,json=iD,proto3" json:"ID,omitempty"`
@@ -1348,16 +1566,18 @@ type MemberUpdateRequest struct {
 func (m *MemberUpdateRequest) Reset()                    { *m = MemberUpdateRequest{} }
 func (m *MemberUpdateRequest) String() string            { return proto.CompactTextString(m) }
 func (*MemberUpdateRequest) ProtoMessage()               {}
-func (*MemberUpdateRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{33} }
+func (*MemberUpdateRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{39} }
 
 type MemberUpdateResponse struct {
 	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+	// members is a list of all members after updating the member.
+	Members []*Member `protobuf:"bytes,2,rep,name=members" json:"members,omitempty"`
 }
 
 func (m *MemberUpdateResponse) Reset()                    { *m = MemberUpdateResponse{} }
 func (m *MemberUpdateResponse) String() string            { return proto.CompactTextString(m) }
 func (*MemberUpdateResponse) ProtoMessage()               {}
-func (*MemberUpdateResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{34} }
+func (*MemberUpdateResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{40} }
 
 func (m *MemberUpdateResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -1366,13 +1586,20 @@ func (m *MemberUpdateResponse) GetHeader() *ResponseHeader {
 	return nil
 }
 
+func (m *MemberUpdateResponse) GetMembers() []*Member {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
 type MemberListRequest struct {
 }
 
 func (m *MemberListRequest) Reset()                    { *m = MemberListRequest{} }
 func (m *MemberListRequest) String() string            { return proto.CompactTextString(m) }
 func (*MemberListRequest) ProtoMessage()               {}
-func (*MemberListRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{35} }
+func (*MemberListRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{41} }
 
 type MemberListResponse struct {
 	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
@@ -1383,7 +1610,7 @@ type MemberListResponse struct {
 func (m *MemberListResponse) Reset()                    { *m = MemberListResponse{} }
 func (m *MemberListResponse) String() string            { return proto.CompactTextString(m) }
 func (*MemberListResponse) ProtoMessage()               {}
-func (*MemberListResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{36} }
+func (*MemberListResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{42} }
 
 func (m *MemberListResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -1405,7 +1632,7 @@ type DefragmentRequest struct {
 func (m *DefragmentRequest) Reset()                    { *m = DefragmentRequest{} }
 func (m *DefragmentRequest) String() string            { return proto.CompactTextString(m) }
 func (*DefragmentRequest) ProtoMessage()               {}
-func (*DefragmentRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{37} }
+func (*DefragmentRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{43} }
 
 type DefragmentResponse struct {
 	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
@@ -1414,7 +1641,7 @@ type DefragmentResponse struct {
 func (m *DefragmentResponse) Reset()                    { *m = DefragmentResponse{} }
 func (m *DefragmentResponse) String() string            { return proto.CompactTextString(m) }
 func (*DefragmentResponse) ProtoMessage()               {}
-func (*DefragmentResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{38} }
+func (*DefragmentResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{44} }
 
 func (m *DefragmentResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -1423,6 +1650,32 @@ func (m *DefragmentResponse) GetHeader() *ResponseHeader {
 	return nil
 }
 
+type MoveLeaderRequest struct {
+	// targetID is the node ID for the new leader.
+	TargetID uint64 `protobuf:"varint,1,opt,name=targetID,proto3" json:"targetID,omitempty"`
+}
+
+func (m *MoveLeaderRequest) Reset()                    { *m = MoveLeaderRequest{} }
+func (m *MoveLeaderRequest) String() string            { return proto.CompactTextString(m) }
+func (*MoveLeaderRequest) ProtoMessage()               {}
+func (*MoveLeaderRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{45} }
+
+type MoveLeaderResponse struct {
+	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+}
+
+func (m *MoveLeaderResponse) Reset()                    { *m = MoveLeaderResponse{} }
+func (m *MoveLeaderResponse) String() string            { return proto.CompactTextString(m) }
+func (*MoveLeaderResponse) ProtoMessage()               {}
+func (*MoveLeaderResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{46} }
+
+func (m *MoveLeaderResponse) GetHeader() *ResponseHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
 type AlarmRequest struct {
 	// action is the kind of alarm request to issue. The action
 	// may GET alarm statuses, ACTIVATE an alarm, or DEACTIVATE a
@@ -1438,7 +1691,7 @@ type AlarmRequest struct {
 func (m *AlarmRequest) Reset()                    { *m = AlarmRequest{} }
 func (m *AlarmRequest) String() string            { return proto.CompactTextString(m) }
 func (*AlarmRequest) ProtoMessage()               {}
-func (*AlarmRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{39} }
+func (*AlarmRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{47} }
 
 type AlarmMember struct {
 	// memberID is the ID of the member associated with the raised alarm.
@@ -1450,7 +1703,7 @@ type AlarmMember struct {
 func (m *AlarmMember) Reset()                    { *m = AlarmMember{} }
 func (m *AlarmMember) String() string            { return proto.CompactTextString(m) }
 func (*AlarmMember) ProtoMessage()               {}
-func (*AlarmMember) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{40} }
+func (*AlarmMember) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{48} }
 
 type AlarmResponse struct {
 	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
@@ -1461,7 +1714,7 @@ type AlarmResponse struct {
 func (m *AlarmResponse) Reset()                    { *m = AlarmResponse{} }
 func (m *AlarmResponse) String() string            { return proto.CompactTextString(m) }
 func (*AlarmResponse) ProtoMessage()               {}
-func (*AlarmResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{41} }
+func (*AlarmResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{49} }
 
 func (m *AlarmResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -1483,7 +1736,7 @@ type StatusRequest struct {
 func (m *StatusRequest) Reset()                    { *m = StatusRequest{} }
 func (m *StatusRequest) String() string            { return proto.CompactTextString(m) }
 func (*StatusRequest) ProtoMessage()               {}
-func (*StatusRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{42} }
+func (*StatusRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{50} }
 
 type StatusResponse struct {
 	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
@@ -1502,7 +1755,7 @@ type StatusResponse struct {
 func (m *StatusResponse) Reset()                    { *m = StatusResponse{} }
 func (m *StatusResponse) String() string            { return proto.CompactTextString(m) }
 func (*StatusResponse) ProtoMessage()               {}
-func (*StatusResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{43} }
+func (*StatusResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{51} }
 
 func (m *StatusResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -1517,7 +1770,7 @@ type AuthEnableRequest struct {
 func (m *AuthEnableRequest) Reset()                    { *m = AuthEnableRequest{} }
 func (m *AuthEnableRequest) String() string            { return proto.CompactTextString(m) }
 func (*AuthEnableRequest) ProtoMessage()               {}
-func (*AuthEnableRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{44} }
+func (*AuthEnableRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{52} }
 
 type AuthDisableRequest struct {
 }
@@ -1525,7 +1778,7 @@ type AuthDisableRequest struct {
 func (m *AuthDisableRequest) Reset()                    { *m = AuthDisableRequest{} }
 func (m *AuthDisableRequest) String() string            { return proto.CompactTextString(m) }
 func (*AuthDisableRequest) ProtoMessage()               {}
-func (*AuthDisableRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{45} }
+func (*AuthDisableRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{53} }
 
 type AuthenticateRequest struct {
 	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -1535,7 +1788,7 @@ type AuthenticateRequest struct {
 func (m *AuthenticateRequest) Reset()                    { *m = AuthenticateRequest{} }
 func (m *AuthenticateRequest) String() string            { return proto.CompactTextString(m) }
 func (*AuthenticateRequest) ProtoMessage()               {}
-func (*AuthenticateRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{46} }
+func (*AuthenticateRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{54} }
 
 type AuthUserAddRequest struct {
 	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -1545,7 +1798,7 @@ type AuthUserAddRequest struct {
 func (m *AuthUserAddRequest) Reset()                    { *m = AuthUserAddRequest{} }
 func (m *AuthUserAddRequest) String() string            { return proto.CompactTextString(m) }
 func (*AuthUserAddRequest) ProtoMessage()               {}
-func (*AuthUserAddRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{47} }
+func (*AuthUserAddRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{55} }
 
 type AuthUserGetRequest struct {
 }
@@ -1553,7 +1806,7 @@ type AuthUserGetRequest struct {
 func (m *AuthUserGetRequest) Reset()                    { *m = AuthUserGetRequest{} }
 func (m *AuthUserGetRequest) String() string            { return proto.CompactTextString(m) }
 func (*AuthUserGetRequest) ProtoMessage()               {}
-func (*AuthUserGetRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{48} }
+func (*AuthUserGetRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{56} }
 
 type AuthUserDeleteRequest struct {
 	// name is the name of the user to delete.
@@ -1563,7 +1816,7 @@ type AuthUserDeleteRequest struct {
 func (m *AuthUserDeleteRequest) Reset()                    { *m = AuthUserDeleteRequest{} }
 func (m *AuthUserDeleteRequest) String() string            { return proto.CompactTextString(m) }
 func (*AuthUserDeleteRequest) ProtoMessage()               {}
-func (*AuthUserDeleteRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{49} }
+func (*AuthUserDeleteRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{57} }
 
 type AuthUserChangePasswordRequest struct {
 	// name is the name of the user whose password is being changed.
@@ -1576,7 +1829,7 @@ func (m *AuthUserChangePasswordRequest) Reset()         { *m = AuthUserChangePas
 func (m *AuthUserChangePasswordRequest) String() string { return proto.CompactTextString(m) }
 func (*AuthUserChangePasswordRequest) ProtoMessage()    {}
 func (*AuthUserChangePasswordRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptorRpc, []int{50}
+	return fileDescriptorRpc, []int{58}
 }
 
 type AuthUserGrantRequest struct {
@@ -1589,15 +1842,19 @@ type AuthUserGrantRequest struct {
 func (m *AuthUserGrantRequest) Reset()                    { *m = AuthUserGrantRequest{} }
 func (m *AuthUserGrantRequest) String() string            { return proto.CompactTextString(m) }
 func (*AuthUserGrantRequest) ProtoMessage()               {}
-func (*AuthUserGrantRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{51} }
+func (*AuthUserGrantRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{59} }
 
 type AuthUserRevokeRequest struct {
+	// user is the name of the user whose role should be revoked.
+	User string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	// role is the name of the role to revoke from the user.
+	Role string `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
 }
 
 func (m *AuthUserRevokeRequest) Reset()                    { *m = AuthUserRevokeRequest{} }
 func (m *AuthUserRevokeRequest) String() string            { return proto.CompactTextString(m) }
 func (*AuthUserRevokeRequest) ProtoMessage()               {}
-func (*AuthUserRevokeRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{52} }
+func (*AuthUserRevokeRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{60} }
 
 type AuthRoleAddRequest struct {
 	// name is the name of the role to add to the authentication system.
@@ -1607,7 +1864,7 @@ type AuthRoleAddRequest struct {
 func (m *AuthRoleAddRequest) Reset()                    { *m = AuthRoleAddRequest{} }
 func (m *AuthRoleAddRequest) String() string            { return proto.CompactTextString(m) }
 func (*AuthRoleAddRequest) ProtoMessage()               {}
-func (*AuthRoleAddRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{53} }
+func (*AuthRoleAddRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{61} }
 
 type AuthRoleGetRequest struct {
 }
@@ -1615,15 +1872,17 @@ type AuthRoleGetRequest struct {
 func (m *AuthRoleGetRequest) Reset()                    { *m = AuthRoleGetRequest{} }
 func (m *AuthRoleGetRequest) String() string            { return proto.CompactTextString(m) }
 func (*AuthRoleGetRequest) ProtoMessage()               {}
-func (*AuthRoleGetRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{54} }
+func (*AuthRoleGetRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{62} }
 
 type AuthRoleDeleteRequest struct {
+	// name is the name of the role to delete.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
 func (m *AuthRoleDeleteRequest) Reset()                    { *m = AuthRoleDeleteRequest{} }
 func (m *AuthRoleDeleteRequest) String() string            { return proto.CompactTextString(m) }
 func (*AuthRoleDeleteRequest) ProtoMessage()               {}
-func (*AuthRoleDeleteRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{55} }
+func (*AuthRoleDeleteRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{63} }
 
 type AuthRoleGrantRequest struct {
 	// name is the name of the role which will be granted the permission.
@@ -1635,7 +1894,7 @@ type AuthRoleGrantRequest struct {
 func (m *AuthRoleGrantRequest) Reset()                    { *m = AuthRoleGrantRequest{} }
 func (m *AuthRoleGrantRequest) String() string            { return proto.CompactTextString(m) }
 func (*AuthRoleGrantRequest) ProtoMessage()               {}
-func (*AuthRoleGrantRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{56} }
+func (*AuthRoleGrantRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{64} }
 
 func (m *AuthRoleGrantRequest) GetPerm() *authpb.Permission {
 	if m != nil {
@@ -1645,12 +1904,18 @@ func (m *AuthRoleGrantRequest) GetPerm() *authpb.Permission {
 }
 
 type AuthRoleRevokeRequest struct {
+	// name is the name of the role whose permission should be revoked.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// key is the first key of the revoked permission.
+	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// range_end is the range end of the revoked permission.
+	RangeEnd string `protobuf:"bytes,3,opt,name=range_end,json=rangeEnd,proto3" json:"range_end,omitempty"`
 }
 
 func (m *AuthRoleRevokeRequest) Reset()                    { *m = AuthRoleRevokeRequest{} }
 func (m *AuthRoleRevokeRequest) String() string            { return proto.CompactTextString(m) }
 func (*AuthRoleRevokeRequest) ProtoMessage()               {}
-func (*AuthRoleRevokeRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{57} }
+func (*AuthRoleRevokeRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{65} }
 
 type AuthEnableResponse struct {
 	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
@@ -1659,7 +1924,7 @@ type AuthEnableResponse struct {
 func (m *AuthEnableResponse) Reset()                    { *m = AuthEnableResponse{} }
 func (m *AuthEnableResponse) String() string            { return proto.CompactTextString(m) }
 func (*AuthEnableResponse) ProtoMessage()               {}
-func (*AuthEnableResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{58} }
+func (*AuthEnableResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{66} }
 
 func (m *AuthEnableResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -1675,7 +1940,7 @@ type AuthDisableResponse struct {
 func (m *AuthDisableResponse) Reset()                    { *m = AuthDisableResponse{} }
 func (m *AuthDisableResponse) String() string            { return proto.CompactTextString(m) }
 func (*AuthDisableResponse) ProtoMessage()               {}
-func (*AuthDisableResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{59} }
+func (*AuthDisableResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{67} }
 
 func (m *AuthDisableResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -1693,7 +1958,7 @@ type AuthenticateResponse struct {
 func (m *AuthenticateResponse) Reset()                    { *m = AuthenticateResponse{} }
 func (m *AuthenticateResponse) String() string            { return proto.CompactTextString(m) }
 func (*AuthenticateResponse) ProtoMessage()               {}
-func (*AuthenticateResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{60} }
+func (*AuthenticateResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{68} }
 
 func (m *AuthenticateResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -1709,7 +1974,7 @@ type AuthUserAddResponse struct {
 func (m *AuthUserAddResponse) Reset()                    { *m = AuthUserAddResponse{} }
 func (m *AuthUserAddResponse) String() string            { return proto.CompactTextString(m) }
 func (*AuthUserAddResponse) ProtoMessage()               {}
-func (*AuthUserAddResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{61} }
+func (*AuthUserAddResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{69} }
 
 func (m *AuthUserAddResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -1725,7 +1990,7 @@ type AuthUserGetResponse struct {
 func (m *AuthUserGetResponse) Reset()                    { *m = AuthUserGetResponse{} }
 func (m *AuthUserGetResponse) String() string            { return proto.CompactTextString(m) }
 func (*AuthUserGetResponse) ProtoMessage()               {}
-func (*AuthUserGetResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{62} }
+func (*AuthUserGetResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{70} }
 
 func (m *AuthUserGetResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -1741,7 +2006,7 @@ type AuthUserDeleteResponse struct {
 func (m *AuthUserDeleteResponse) Reset()                    { *m = AuthUserDeleteResponse{} }
 func (m *AuthUserDeleteResponse) String() string            { return proto.CompactTextString(m) }
 func (*AuthUserDeleteResponse) ProtoMessage()               {}
-func (*AuthUserDeleteResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{63} }
+func (*AuthUserDeleteResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{71} }
 
 func (m *AuthUserDeleteResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -1758,7 +2023,7 @@ func (m *AuthUserChangePasswordResponse) Reset()         { *m = AuthUserChangePa
 func (m *AuthUserChangePasswordResponse) String() string { return proto.CompactTextString(m) }
 func (*AuthUserChangePasswordResponse) ProtoMessage()    {}
 func (*AuthUserChangePasswordResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptorRpc, []int{64}
+	return fileDescriptorRpc, []int{72}
 }
 
 func (m *AuthUserChangePasswordResponse) GetHeader() *ResponseHeader {
@@ -1775,7 +2040,7 @@ type AuthUserGrantResponse struct {
 func (m *AuthUserGrantResponse) Reset()                    { *m = AuthUserGrantResponse{} }
 func (m *AuthUserGrantResponse) String() string            { return proto.CompactTextString(m) }
 func (*AuthUserGrantResponse) ProtoMessage()               {}
-func (*AuthUserGrantResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{65} }
+func (*AuthUserGrantResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{73} }
 
 func (m *AuthUserGrantResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -1791,7 +2056,7 @@ type AuthUserRevokeResponse struct {
 func (m *AuthUserRevokeResponse) Reset()                    { *m = AuthUserRevokeResponse{} }
 func (m *AuthUserRevokeResponse) String() string            { return proto.CompactTextString(m) }
 func (*AuthUserRevokeResponse) ProtoMessage()               {}
-func (*AuthUserRevokeResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{66} }
+func (*AuthUserRevokeResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{74} }
 
 func (m *AuthUserRevokeResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -1807,7 +2072,7 @@ type AuthRoleAddResponse struct {
 func (m *AuthRoleAddResponse) Reset()                    { *m = AuthRoleAddResponse{} }
 func (m *AuthRoleAddResponse) String() string            { return proto.CompactTextString(m) }
 func (*AuthRoleAddResponse) ProtoMessage()               {}
-func (*AuthRoleAddResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{67} }
+func (*AuthRoleAddResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{75} }
 
 func (m *AuthRoleAddResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -1823,7 +2088,7 @@ type AuthRoleGetResponse struct {
 func (m *AuthRoleGetResponse) Reset()                    { *m = AuthRoleGetResponse{} }
 func (m *AuthRoleGetResponse) String() string            { return proto.CompactTextString(m) }
 func (*AuthRoleGetResponse) ProtoMessage()               {}
-func (*AuthRoleGetResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{68} }
+func (*AuthRoleGetResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{76} }
 
 func (m *AuthRoleGetResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -1839,7 +2104,7 @@ type AuthRoleDeleteResponse struct {
 func (m *AuthRoleDeleteResponse) Reset()                    { *m = AuthRoleDeleteResponse{} }
 func (m *AuthRoleDeleteResponse) String() string            { return proto.CompactTextString(m) }
 func (*AuthRoleDeleteResponse) ProtoMessage()               {}
-func (*AuthRoleDeleteResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{69} }
+func (*AuthRoleDeleteResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{77} }
 
 func (m *AuthRoleDeleteResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -1855,7 +2120,7 @@ type AuthRoleGrantResponse struct {
 func (m *AuthRoleGrantResponse) Reset()                    { *m = AuthRoleGrantResponse{} }
 func (m *AuthRoleGrantResponse) String() string            { return proto.CompactTextString(m) }
 func (*AuthRoleGrantResponse) ProtoMessage()               {}
-func (*AuthRoleGrantResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{70} }
+func (*AuthRoleGrantResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{78} }
 
 func (m *AuthRoleGrantResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -1871,7 +2136,7 @@ type AuthRoleRevokeResponse struct {
 func (m *AuthRoleRevokeResponse) Reset()                    { *m = AuthRoleRevokeResponse{} }
 func (m *AuthRoleRevokeResponse) String() string            { return proto.CompactTextString(m) }
 func (*AuthRoleRevokeResponse) ProtoMessage()               {}
-func (*AuthRoleRevokeResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{71} }
+func (*AuthRoleRevokeResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{79} }
 
 func (m *AuthRoleRevokeResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -1902,6 +2167,7 @@ func init() {
 	proto.RegisterType((*WatchRequest)(nil), "etcdserverpb.WatchRequest")
 	proto.RegisterType((*WatchCreateRequest)(nil), "etcdserverpb.WatchCreateRequest")
 	proto.RegisterType((*WatchCancelRequest)(nil), "etcdserverpb.WatchCancelRequest")
+	proto.RegisterType((*WatchProgressRequest)(nil), "etcdserverpb.WatchProgressRequest")
 	proto.RegisterType((*WatchResponse)(nil), "etcdserverpb.WatchResponse")
 	proto.RegisterType((*LeaseGrantRequest)(nil), "etcdserverpb.LeaseGrantRequest")
 	proto.RegisterType((*LeaseGrantResponse)(nil), "etcdserverpb.LeaseGrantResponse")
@@ -1909,6 +2175,11 @@ func init() {
 	proto.RegisterType((*LeaseRevokeResponse)(nil), "etcdserverpb.LeaseRevokeResponse")
 	proto.RegisterType((*LeaseKeepAliveRequest)(nil), "etcdserverpb.LeaseKeepAliveRequest")
 	proto.RegisterType((*LeaseKeepAliveResponse)(nil), "etcdserverpb.LeaseKeepAliveResponse")
+	proto.RegisterType((*LeaseTimeToLiveRequest)(nil), "etcdserverpb.LeaseTimeToLiveRequest")
+	proto.RegisterType((*LeaseTimeToLiveResponse)(nil), "etcdserverpb.LeaseTimeToLiveResponse")
+	proto.RegisterType((*LeaseLeasesRequest)(nil), "etcdserverpb.LeaseLeasesRequest")
+	proto.RegisterType((*LeaseStatus)(nil), "etcdserverpb.LeaseStatus")
+	proto.RegisterType((*LeaseLeasesResponse)(nil), "etcdserverpb.LeaseLeasesResponse")
 	proto.RegisterType((*Member)(nil), "etcdserverpb.Member")
 	proto.RegisterType((*MemberAddRequest)(nil), "etcdserverpb.MemberAddRequest")
 	proto.RegisterType((*MemberAddResponse)(nil), "etcdserverpb.MemberAddResponse")
@@ -1920,6 +2191,8 @@ func init() {
 	proto.RegisterType((*MemberListResponse)(nil), "etcdserverpb.MemberListResponse")
 	proto.RegisterType((*DefragmentRequest)(nil), "etcdserverpb.DefragmentRequest")
 	proto.RegisterType((*DefragmentResponse)(nil), "etcdserverpb.DefragmentResponse")
+	proto.RegisterType((*MoveLeaderRequest)(nil), "etcdserverpb.MoveLeaderRequest")
+	proto.RegisterType((*MoveLeaderResponse)(nil), "etcdserverpb.MoveLeaderResponse")
 	proto.RegisterType((*AlarmRequest)(nil), "etcdserverpb.AlarmRequest")
 	proto.RegisterType((*AlarmMember)(nil), "etcdserverpb.AlarmMember")
 	proto.RegisterType((*AlarmResponse)(nil), "etcdserverpb.AlarmResponse")
@@ -1958,6 +2231,7 @@ func init() {
 	proto.RegisterEnum("etcdserverpb.RangeRequest_SortTarget", RangeRequest_SortTarget_name, RangeRequest_SortTarget_value)
 	proto.RegisterEnum("etcdserverpb.Compare_CompareResult", Compare_CompareResult_name, Compare_CompareResult_value)
 	proto.RegisterEnum("etcdserverpb.Compare_CompareTarget", Compare_CompareTarget_name, Compare_CompareTarget_value)
+	proto.RegisterEnum("etcdserverpb.WatchCreateRequest_FilterType", WatchCreateRequest_FilterType_name, WatchCreateRequest_FilterType_value)
 	proto.RegisterEnum("etcdserverpb.AlarmRequest_AlarmAction", AlarmRequest_AlarmAction_name, AlarmRequest_AlarmAction_value)
 }
 
@@ -2309,6 +2583,10 @@ type LeaseClient interface {
 	// LeaseKeepAlive keeps the lease alive by streaming keep alive requests from the client
 	// to the server and streaming keep alive responses from the server to the client.
 	LeaseKeepAlive(ctx context.Context, opts ...grpc.CallOption) (Lease_LeaseKeepAliveClient, error)
+	// LeaseTimeToLive retrieves lease information.
+	LeaseTimeToLive(ctx context.Context, in *LeaseTimeToLiveRequest, opts ...grpc.CallOption) (*LeaseTimeToLiveResponse, error)
+	// LeaseLeases lists all existing leases.
+	LeaseLeases(ctx context.Context, in *LeaseLeasesRequest, opts ...grpc.CallOption) (*LeaseLeasesResponse, error)
 }
 
 type leaseClient struct {
@@ -2368,6 +2646,24 @@ func (x *leaseLeaseKeepAliveClient) Recv() (*LeaseKeepAliveResponse, error) {
 	return m, nil
 }
 
+func (c *leaseClient) LeaseTimeToLive(ctx context.Context, in *LeaseTimeToLiveRequest, opts ...grpc.CallOption) (*LeaseTimeToLiveResponse, error) {
+	out := new(LeaseTimeToLiveResponse)
+	err := grpc.Invoke(ctx, "/etcdserverpb.Lease/LeaseTimeToLive", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaseClient) LeaseLeases(ctx context.Context, in *LeaseLeasesRequest, opts ...grpc.CallOption) (*LeaseLeasesResponse, error) {
+	out := new(LeaseLeasesResponse)
+	err := grpc.Invoke(ctx, "/etcdserverpb.Lease/LeaseLeases", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Lease service
 
 type LeaseServer interface {
@@ -2380,6 +2676,10 @@ type LeaseServer interface {
 	// LeaseKeepAlive keeps the lease alive by streaming keep alive requests from the client
 	// to the server and streaming keep alive responses from the server to the client.
 	LeaseKeepAlive(Lease_LeaseKeepAliveServer) error
+	// LeaseTimeToLive retrieves lease information.
+	LeaseTimeToLive(context.Context, *LeaseTimeToLiveRequest) (*LeaseTimeToLiveResponse, error)
+	// LeaseLeases lists all existing leases.
+	LeaseLeases(context.Context, *LeaseLeasesRequest) (*LeaseLeasesResponse, error)
 }
 
 func RegisterLeaseServer(s *grpc.Server, srv LeaseServer) {
@@ -2448,6 +2748,42 @@ func (x *leaseLeaseKeepAliveServer) Recv() (*LeaseKeepAliveRequest, error) {
 	return m, nil
 }
 
+func _Lease_LeaseTimeToLive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaseTimeToLiveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaseServer).LeaseTimeToLive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/etcdserverpb.Lease/LeaseTimeToLive",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaseServer).LeaseTimeToLive(ctx, req.(*LeaseTimeToLiveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lease_LeaseLeases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaseLeasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaseServer).LeaseLeases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/etcdserverpb.Lease/LeaseLeases",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaseServer).LeaseLeases(ctx, req.(*LeaseLeasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Lease_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "etcdserverpb.Lease",
 	HandlerType: (*LeaseServer)(nil),
@@ -2460,6 +2796,14 @@ var _Lease_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LeaseRevoke",
 			Handler:    _Lease_LeaseRevoke_Handler,
 		},
+		{
+			MethodName: "LeaseTimeToLive",
+			Handler:    _Lease_LeaseTimeToLive_Handler,
+		},
+		{
+			MethodName: "LeaseLeases",
+			Handler:    _Lease_LeaseLeases_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -2656,6 +3000,8 @@ type MaintenanceClient interface {
 	Hash(ctx context.Context, in *HashRequest, opts ...grpc.CallOption) (*HashResponse, error)
 	// Snapshot sends a snapshot of the entire backend from a member over a stream to a client.
 	Snapshot(ctx context.Context, in *SnapshotRequest, opts ...grpc.CallOption) (Maintenance_SnapshotClient, error)
+	// MoveLeader requests current leader node to transfer its leadership to transferee.
+	MoveLeader(ctx context.Context, in *MoveLeaderRequest, opts ...grpc.CallOption) (*MoveLeaderResponse, error)
 }
 
 type maintenanceClient struct {
@@ -2734,6 +3080,15 @@ func (x *maintenanceSnapshotClient) Recv() (*SnapshotResponse, error) {
 	return m, nil
 }
 
+func (c *maintenanceClient) MoveLeader(ctx context.Context, in *MoveLeaderRequest, opts ...grpc.CallOption) (*MoveLeaderResponse, error) {
+	out := new(MoveLeaderResponse)
+	err := grpc.Invoke(ctx, "/etcdserverpb.Maintenance/MoveLeader", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Maintenance service
 
 type MaintenanceServer interface {
@@ -2749,6 +3104,8 @@ type MaintenanceServer interface {
 	Hash(context.Context, *HashRequest) (*HashResponse, error)
 	// Snapshot sends a snapshot of the entire backend from a member over a stream to a client.
 	Snapshot(*SnapshotRequest, Maintenance_SnapshotServer) error
+	// MoveLeader requests current leader node to transfer its leadership to transferee.
+	MoveLeader(context.Context, *MoveLeaderRequest) (*MoveLeaderResponse, error)
 }
 
 func RegisterMaintenanceServer(s *grpc.Server, srv MaintenanceServer) {
@@ -2848,6 +3205,24 @@ func (x *maintenanceSnapshotServer) Send(m *SnapshotResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Maintenance_MoveLeader_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveLeaderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MaintenanceServer).MoveLeader(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/etcdserverpb.Maintenance/MoveLeader",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MaintenanceServer).MoveLeader(ctx, req.(*MoveLeaderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Maintenance_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "etcdserverpb.Maintenance",
 	HandlerType: (*MaintenanceServer)(nil),
@@ -2868,6 +3243,10 @@ var _Maintenance_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Hash",
 			Handler:    _Maintenance_Hash_Handler,
 		},
+		{
+			MethodName: "MoveLeader",
+			Handler:    _Maintenance_MoveLeader_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -3493,6 +3872,46 @@ func (m *RangeRequest) MarshalTo(data []byte) (int, error) {
 		}
 		i++
 	}
+	if m.CountOnly {
+		data[i] = 0x40
+		i++
+		if m.CountOnly {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
+	if m.KeysOnly {
+		data[i] = 0x48
+		i++
+		if m.KeysOnly {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
+	if m.MinCreateRevision != 0 {
+		data[i] = 0x50
+		i++
+		i = encodeVarintRpc(data, i, uint64(m.MinCreateRevision))
+	}
+	if m.MaxCreateRevision != 0 {
+		data[i] = 0x58
+		i++
+		i = encodeVarintRpc(data, i, uint64(m.MaxCreateRevision))
+	}
+	if m.MinModRevision != 0 {
+		data[i] = 0x60
+		i++
+		i = encodeVarintRpc(data, i, uint64(m.MinModRevision))
+	}
+	if m.MaxModRevision != 0 {
+		data[i] = 0x68
+		i++
+		i = encodeVarintRpc(data, i, uint64(m.MaxModRevision))
+	}
 	return i, nil
 }
 
@@ -3543,6 +3962,11 @@ func (m *RangeResponse) MarshalTo(data []byte) (int, error) {
 		}
 		i++
 	}
+	if m.Count != 0 {
+		data[i] = 0x20
+		i++
+		i = encodeVarintRpc(data, i, uint64(m.Count))
+	}
 	return i, nil
 }
 
@@ -3578,6 +4002,36 @@ func (m *PutRequest) MarshalTo(data []byte) (int, error) {
 		i++
 		i = encodeVarintRpc(data, i, uint64(m.Lease))
 	}
+	if m.IgnoreValue {
+		data[i] = 0x20
+		i++
+		if m.IgnoreValue {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
+	if m.IgnoreLease {
+		data[i] = 0x28
+		i++
+		if m.IgnoreLease {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
+	if m.PrevKv {
+		data[i] = 0x30
+		i++
+		if m.PrevKv {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
 	return i, nil
 }
 
@@ -3606,6 +4060,16 @@ func (m *PutResponse) MarshalTo(data []byte) (int, error) {
 		}
 		i += n2
 	}
+	if m.PrevKv != nil {
+		data[i] = 0x12
+		i++
+		i = encodeVarintRpc(data, i, uint64(m.PrevKv.Size()))
+		n3, err := m.PrevKv.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
 	return i, nil
 }
 
@@ -3636,6 +4100,16 @@ func (m *DeleteRangeRequest) MarshalTo(data []byte) (int, error) {
 		i = encodeVarintRpc(data, i, uint64(len(m.RangeEnd)))
 		i += copy(data[i:], m.RangeEnd)
 	}
+	if m.PrevKv {
+		data[i] = 0x18
+		i++
+		if m.PrevKv {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
 	return i, nil
 }
 
@@ -3658,17 +4132,29 @@ func (m *DeleteRangeResponse) MarshalTo(data []byte) (int, error) {
 		data[i] = 0xa
 		i++
 		i = encodeVarintRpc(data, i, uint64(m.Header.Size()))
-		n3, err := m.Header.MarshalTo(data[i:])
+		n4, err := m.Header.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n3
+		i += n4
 	}
 	if m.Deleted != 0 {
 		data[i] = 0x10
 		i++
 		i = encodeVarintRpc(data, i, uint64(m.Deleted))
 	}
+	if len(m.PrevKvs) > 0 {
+		for _, msg := range m.PrevKvs {
+			data[i] = 0x1a
+			i++
+			i = encodeVarintRpc(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	return i, nil
 }
 
@@ -3688,11 +4174,11 @@ func (m *RequestUnion) MarshalTo(data []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Request != nil {
-		nn4, err := m.Request.MarshalTo(data[i:])
+		nn5, err := m.Request.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += nn4
+		i += nn5
 	}
 	return i, nil
 }
@@ -3703,11 +4189,11 @@ func (m *RequestUnion_RequestRange) MarshalTo(data []byte) (int, error) {
 		data[i] = 0xa
 		i++
 		i = encodeVarintRpc(data, i, uint64(m.RequestRange.Size()))
-		n5, err := m.RequestRange.MarshalTo(data[i:])
+		n6, err := m.RequestRange.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n5
+		i += n6
 	}
 	return i, nil
 }
@@ -3717,11 +4203,11 @@ func (m *RequestUnion_RequestPut) MarshalTo(data []byte) (int, error) {
 		data[i] = 0x12
 		i++
 		i = encodeVarintRpc(data, i, uint64(m.RequestPut.Size()))
-		n6, err := m.RequestPut.MarshalTo(data[i:])
+		n7, err := m.RequestPut.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n6
+		i += n7
 	}
 	return i, nil
 }
@@ -3731,11 +4217,11 @@ func (m *RequestUnion_RequestDeleteRange) MarshalTo(data []byte) (int, error) {
 		data[i] = 0x1a
 		i++
 		i = encodeVarintRpc(data, i, uint64(m.RequestDeleteRange.Size()))
-		n7, err := m.RequestDeleteRange.MarshalTo(data[i:])
+		n8, err := m.RequestDeleteRange.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n7
+		i += n8
 	}
 	return i, nil
 }
@@ -3755,11 +4241,11 @@ func (m *ResponseUnion) MarshalTo(data []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Response != nil {
-		nn8, err := m.Response.MarshalTo(data[i:])
+		nn9, err := m.Response.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += nn8
+		i += nn9
 	}
 	return i, nil
 }
@@ -3770,11 +4256,11 @@ func (m *ResponseUnion_ResponseRange) MarshalTo(data []byte) (int, error) {
 		data[i] = 0xa
 		i++
 		i = encodeVarintRpc(data, i, uint64(m.ResponseRange.Size()))
-		n9, err := m.ResponseRange.MarshalTo(data[i:])
+		n10, err := m.ResponseRange.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n9
+		i += n10
 	}
 	return i, nil
 }
@@ -3784,11 +4270,11 @@ func (m *ResponseUnion_ResponsePut) MarshalTo(data []byte) (int, error) {
 		data[i] = 0x12
 		i++
 		i = encodeVarintRpc(data, i, uint64(m.ResponsePut.Size()))
-		n10, err := m.ResponsePut.MarshalTo(data[i:])
+		n11, err := m.ResponsePut.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n10
+		i += n11
 	}
 	return i, nil
 }
@@ -3798,11 +4284,11 @@ func (m *ResponseUnion_ResponseDeleteRange) MarshalTo(data []byte) (int, error)
 		data[i] = 0x1a
 		i++
 		i = encodeVarintRpc(data, i, uint64(m.ResponseDeleteRange.Size()))
-		n11, err := m.ResponseDeleteRange.MarshalTo(data[i:])
+		n12, err := m.ResponseDeleteRange.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n11
+		i += n12
 	}
 	return i, nil
 }
@@ -3838,11 +4324,11 @@ func (m *Compare) MarshalTo(data []byte) (int, error) {
 		i += copy(data[i:], m.Key)
 	}
 	if m.TargetUnion != nil {
-		nn12, err := m.TargetUnion.MarshalTo(data[i:])
+		nn13, err := m.TargetUnion.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += nn12
+		i += nn13
 	}
 	return i, nil
 }
@@ -3951,11 +4437,11 @@ func (m *TxnResponse) MarshalTo(data []byte) (int, error) {
 		data[i] = 0xa
 		i++
 		i = encodeVarintRpc(data, i, uint64(m.Header.Size()))
-		n13, err := m.Header.MarshalTo(data[i:])
+		n14, err := m.Header.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n13
+		i += n14
 	}
 	if m.Succeeded {
 		data[i] = 0x10
@@ -4034,11 +4520,11 @@ func (m *CompactionResponse) MarshalTo(data []byte) (int, error) {
 		data[i] = 0xa
 		i++
 		i = encodeVarintRpc(data, i, uint64(m.Header.Size()))
-		n14, err := m.Header.MarshalTo(data[i:])
+		n15, err := m.Header.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n14
+		i += n15
 	}
 	return i, nil
 }
@@ -4080,11 +4566,11 @@ func (m *HashResponse) MarshalTo(data []byte) (int, error) {
 		data[i] = 0xa
 		i++
 		i = encodeVarintRpc(data, i, uint64(m.Header.Size()))
-		n15, err := m.Header.MarshalTo(data[i:])
+		n16, err := m.Header.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n15
+		i += n16
 	}
 	if m.Hash != 0 {
 		data[i] = 0x10
@@ -4131,11 +4617,11 @@ func (m *SnapshotResponse) MarshalTo(data []byte) (int, error) {
 		data[i] = 0xa
 		i++
 		i = encodeVarintRpc(data, i, uint64(m.Header.Size()))
-		n16, err := m.Header.MarshalTo(data[i:])
+		n17, err := m.Header.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n16
+		i += n17
 	}
 	if m.RemainingBytes != 0 {
 		data[i] = 0x10
@@ -4167,11 +4653,11 @@ func (m *WatchRequest) MarshalTo(data []byte) (int, error) {
 	var l int
 	_ = l
 	if m.RequestUnion != nil {
-		nn17, err := m.RequestUnion.MarshalTo(data[i:])
+		nn18, err := m.RequestUnion.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += nn17
+		i += nn18
 	}
 	return i, nil
 }
@@ -4182,11 +4668,11 @@ func (m *WatchRequest_CreateRequest) MarshalTo(data []byte) (int, error) {
 		data[i] = 0xa
 		i++
 		i = encodeVarintRpc(data, i, uint64(m.CreateRequest.Size()))
-		n18, err := m.CreateRequest.MarshalTo(data[i:])
+		n19, err := m.CreateRequest.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n18
+		i += n19
 	}
 	return i, nil
 }
@@ -4196,11 +4682,25 @@ func (m *WatchRequest_CancelRequest) MarshalTo(data []byte) (int, error) {
 		data[i] = 0x12
 		i++
 		i = encodeVarintRpc(data, i, uint64(m.CancelRequest.Size()))
-		n19, err := m.CancelRequest.MarshalTo(data[i:])
+		n20, err := m.CancelRequest.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n19
+		i += n20
+	}
+	return i, nil
+}
+func (m *WatchRequest_ProgressRequest) MarshalTo(data []byte) (int, error) {
+	i := 0
+	if m.ProgressRequest != nil {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintRpc(data, i, uint64(m.ProgressRequest.Size()))
+		n21, err := m.ProgressRequest.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n21
 	}
 	return i, nil
 }
@@ -4246,6 +4746,33 @@ func (m *WatchCreateRequest) MarshalTo(data []byte) (int, error) {
 		}
 		i++
 	}
+	if len(m.Filters) > 0 {
+		data23 := make([]byte, len(m.Filters)*10)
+		var j22 int
+		for _, num := range m.Filters {
+			for num >= 1<<7 {
+				data23[j22] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j22++
+			}
+			data23[j22] = uint8(num)
+			j22++
+		}
+		data[i] = 0x2a
+		i++
+		i = encodeVarintRpc(data, i, uint64(j22))
+		i += copy(data[i:], data23[:j22])
+	}
+	if m.PrevKv {
+		data[i] = 0x30
+		i++
+		if m.PrevKv {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
 	return i, nil
 }
 
@@ -4272,6 +4799,24 @@ func (m *WatchCancelRequest) MarshalTo(data []byte) (int, error) {
 	return i, nil
 }
 
+func (m *WatchProgressRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *WatchProgressRequest) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
 func (m *WatchResponse) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
@@ -4291,11 +4836,11 @@ func (m *WatchResponse) MarshalTo(data []byte) (int, error) {
 		data[i] = 0xa
 		i++
 		i = encodeVarintRpc(data, i, uint64(m.Header.Size()))
-		n20, err := m.Header.MarshalTo(data[i:])
+		n24, err := m.Header.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n20
+		i += n24
 	}
 	if m.WatchId != 0 {
 		data[i] = 0x10
@@ -4389,11 +4934,11 @@ func (m *LeaseGrantResponse) MarshalTo(data []byte) (int, error) {
 		data[i] = 0xa
 		i++
 		i = encodeVarintRpc(data, i, uint64(m.Header.Size()))
-		n21, err := m.Header.MarshalTo(data[i:])
+		n25, err := m.Header.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n21
+		i += n25
 	}
 	if m.ID != 0 {
 		data[i] = 0x10
@@ -4456,11 +5001,11 @@ func (m *LeaseRevokeResponse) MarshalTo(data []byte) (int, error) {
 		data[i] = 0xa
 		i++
 		i = encodeVarintRpc(data, i, uint64(m.Header.Size()))
-		n22, err := m.Header.MarshalTo(data[i:])
+		n26, err := m.Header.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n22
+		i += n26
 	}
 	return i, nil
 }
@@ -4507,11 +5052,82 @@ func (m *LeaseKeepAliveResponse) MarshalTo(data []byte) (int, error) {
 		data[i] = 0xa
 		i++
 		i = encodeVarintRpc(data, i, uint64(m.Header.Size()))
-		n23, err := m.Header.MarshalTo(data[i:])
+		n27, err := m.Header.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n27
+	}
+	if m.ID != 0 {
+		data[i] = 0x10
+		i++
+		i = encodeVarintRpc(data, i, uint64(m.ID))
+	}
+	if m.TTL != 0 {
+		data[i] = 0x18
+		i++
+		i = encodeVarintRpc(data, i, uint64(m.TTL))
+	}
+	return i, nil
+}
+
+func (m *LeaseTimeToLiveRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *LeaseTimeToLiveRequest) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.ID != 0 {
+		data[i] = 0x8
+		i++
+		i = encodeVarintRpc(data, i, uint64(m.ID))
+	}
+	if m.Keys {
+		data[i] = 0x10
+		i++
+		if m.Keys {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *LeaseTimeToLiveResponse) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *LeaseTimeToLiveResponse) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Header != nil {
+		data[i] = 0xa
+		i++
+		i = encodeVarintRpc(data, i, uint64(m.Header.Size()))
+		n28, err := m.Header.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n23
+		i += n28
 	}
 	if m.ID != 0 {
 		data[i] = 0x10
@@ -4523,6 +5139,100 @@ func (m *LeaseKeepAliveResponse) MarshalTo(data []byte) (int, error) {
 		i++
 		i = encodeVarintRpc(data, i, uint64(m.TTL))
 	}
+	if m.GrantedTTL != 0 {
+		data[i] = 0x20
+		i++
+		i = encodeVarintRpc(data, i, uint64(m.GrantedTTL))
+	}
+	if len(m.Keys) > 0 {
+		for _, b := range m.Keys {
+			data[i] = 0x2a
+			i++
+			i = encodeVarintRpc(data, i, uint64(len(b)))
+			i += copy(data[i:], b)
+		}
+	}
+	return i, nil
+}
+
+func (m *LeaseLeasesRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *LeaseLeasesRequest) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *LeaseStatus) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *LeaseStatus) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.ID != 0 {
+		data[i] = 0x8
+		i++
+		i = encodeVarintRpc(data, i, uint64(m.ID))
+	}
+	return i, nil
+}
+
+func (m *LeaseLeasesResponse) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *LeaseLeasesResponse) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Header != nil {
+		data[i] = 0xa
+		i++
+		i = encodeVarintRpc(data, i, uint64(m.Header.Size()))
+		n29, err := m.Header.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n29
+	}
+	if len(m.Leases) > 0 {
+		for _, msg := range m.Leases {
+			data[i] = 0x12
+			i++
+			i = encodeVarintRpc(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	return i, nil
 }
 
@@ -4637,21 +5347,33 @@ func (m *MemberAddResponse) MarshalTo(data []byte) (int, error) {
 		data[i] = 0xa
 		i++
 		i = encodeVarintRpc(data, i, uint64(m.Header.Size()))
-		n24, err := m.Header.MarshalTo(data[i:])
+		n30, err := m.Header.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n24
+		i += n30
 	}
 	if m.Member != nil {
 		data[i] = 0x12
 		i++
 		i = encodeVarintRpc(data, i, uint64(m.Member.Size()))
-		n25, err := m.Member.MarshalTo(data[i:])
+		n31, err := m.Member.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n25
+		i += n31
+	}
+	if len(m.Members) > 0 {
+		for _, msg := range m.Members {
+			data[i] = 0x1a
+			i++
+			i = encodeVarintRpc(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
 	return i, nil
 }
@@ -4698,11 +5420,23 @@ func (m *MemberRemoveResponse) MarshalTo(data []byte) (int, error) {
 		data[i] = 0xa
 		i++
 		i = encodeVarintRpc(data, i, uint64(m.Header.Size()))
-		n26, err := m.Header.MarshalTo(data[i:])
+		n32, err := m.Header.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n26
+		i += n32
+	}
+	if len(m.Members) > 0 {
+		for _, msg := range m.Members {
+			data[i] = 0x12
+			i++
+			i = encodeVarintRpc(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
 	return i, nil
 }
@@ -4764,21 +5498,33 @@ func (m *MemberUpdateResponse) MarshalTo(data []byte) (int, error) {
 		data[i] = 0xa
 		i++
 		i = encodeVarintRpc(data, i, uint64(m.Header.Size()))
-		n27, err := m.Header.MarshalTo(data[i:])
+		n33, err := m.Header.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n27
+		i += n33
 	}
-	return i, nil
-}
-
-func (m *MemberListRequest) Marshal() (data []byte, err error) {
-	size := m.Size()
-	data = make([]byte, size)
-	n, err := m.MarshalTo(data)
-	if err != nil {
-		return nil, err
+	if len(m.Members) > 0 {
+		for _, msg := range m.Members {
+			data[i] = 0x12
+			i++
+			i = encodeVarintRpc(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *MemberListRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
 	}
 	return data[:n], nil
 }
@@ -4810,11 +5556,11 @@ func (m *MemberListResponse) MarshalTo(data []byte) (int, error) {
 		data[i] = 0xa
 		i++
 		i = encodeVarintRpc(data, i, uint64(m.Header.Size()))
-		n28, err := m.Header.MarshalTo(data[i:])
+		n34, err := m.Header.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n28
+		i += n34
 	}
 	if len(m.Members) > 0 {
 		for _, msg := range m.Members {
@@ -4868,11 +5614,62 @@ func (m *DefragmentResponse) MarshalTo(data []byte) (int, error) {
 		data[i] = 0xa
 		i++
 		i = encodeVarintRpc(data, i, uint64(m.Header.Size()))
-		n29, err := m.Header.MarshalTo(data[i:])
+		n35, err := m.Header.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n29
+		i += n35
+	}
+	return i, nil
+}
+
+func (m *MoveLeaderRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *MoveLeaderRequest) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.TargetID != 0 {
+		data[i] = 0x8
+		i++
+		i = encodeVarintRpc(data, i, uint64(m.TargetID))
+	}
+	return i, nil
+}
+
+func (m *MoveLeaderResponse) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *MoveLeaderResponse) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Header != nil {
+		data[i] = 0xa
+		i++
+		i = encodeVarintRpc(data, i, uint64(m.Header.Size()))
+		n36, err := m.Header.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n36
 	}
 	return i, nil
 }
@@ -4957,11 +5754,11 @@ func (m *AlarmResponse) MarshalTo(data []byte) (int, error) {
 		data[i] = 0xa
 		i++
 		i = encodeVarintRpc(data, i, uint64(m.Header.Size()))
-		n30, err := m.Header.MarshalTo(data[i:])
+		n37, err := m.Header.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n30
+		i += n37
 	}
 	if len(m.Alarms) > 0 {
 		for _, msg := range m.Alarms {
@@ -5015,11 +5812,11 @@ func (m *StatusResponse) MarshalTo(data []byte) (int, error) {
 		data[i] = 0xa
 		i++
 		i = encodeVarintRpc(data, i, uint64(m.Header.Size()))
-		n31, err := m.Header.MarshalTo(data[i:])
+		n38, err := m.Header.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n31
+		i += n38
 	}
 	if len(m.Version) > 0 {
 		data[i] = 0x12
@@ -5263,6 +6060,18 @@ func (m *AuthUserRevokeRequest) MarshalTo(data []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.User) > 0 {
+		data[i] = 0xa
+		i++
+		i = encodeVarintRpc(data, i, uint64(len(m.User)))
+		i += copy(data[i:], m.User)
+	}
+	if len(m.Role) > 0 {
+		data[i] = 0x12
+		i++
+		i = encodeVarintRpc(data, i, uint64(len(m.Role)))
+		i += copy(data[i:], m.Role)
+	}
 	return i, nil
 }
 
@@ -5323,6 +6132,12 @@ func (m *AuthRoleDeleteRequest) MarshalTo(data []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Name) > 0 {
+		data[i] = 0xa
+		i++
+		i = encodeVarintRpc(data, i, uint64(len(m.Name)))
+		i += copy(data[i:], m.Name)
+	}
 	return i, nil
 }
 
@@ -5351,11 +6166,11 @@ func (m *AuthRoleGrantRequest) MarshalTo(data []byte) (int, error) {
 		data[i] = 0x12
 		i++
 		i = encodeVarintRpc(data, i, uint64(m.Perm.Size()))
-		n32, err := m.Perm.MarshalTo(data[i:])
+		n39, err := m.Perm.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n32
+		i += n39
 	}
 	return i, nil
 }
@@ -5375,6 +6190,24 @@ func (m *AuthRoleRevokeRequest) MarshalTo(data []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Name) > 0 {
+		data[i] = 0xa
+		i++
+		i = encodeVarintRpc(data, i, uint64(len(m.Name)))
+		i += copy(data[i:], m.Name)
+	}
+	if len(m.Key) > 0 {
+		data[i] = 0x12
+		i++
+		i = encodeVarintRpc(data, i, uint64(len(m.Key)))
+		i += copy(data[i:], m.Key)
+	}
+	if len(m.RangeEnd) > 0 {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintRpc(data, i, uint64(len(m.RangeEnd)))
+		i += copy(data[i:], m.RangeEnd)
+	}
 	return i, nil
 }
 
@@ -5397,11 +6230,11 @@ func (m *AuthEnableResponse) MarshalTo(data []byte) (int, error) {
 		data[i] = 0xa
 		i++
 		i = encodeVarintRpc(data, i, uint64(m.Header.Size()))
-		n33, err := m.Header.MarshalTo(data[i:])
+		n40, err := m.Header.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n33
+		i += n40
 	}
 	return i, nil
 }
@@ -5425,11 +6258,11 @@ func (m *AuthDisableResponse) MarshalTo(data []byte) (int, error) {
 		data[i] = 0xa
 		i++
 		i = encodeVarintRpc(data, i, uint64(m.Header.Size()))
-		n34, err := m.Header.MarshalTo(data[i:])
+		n41, err := m.Header.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n34
+		i += n41
 	}
 	return i, nil
 }
@@ -5453,11 +6286,11 @@ func (m *AuthenticateResponse) MarshalTo(data []byte) (int, error) {
 		data[i] = 0xa
 		i++
 		i = encodeVarintRpc(data, i, uint64(m.Header.Size()))
-		n35, err := m.Header.MarshalTo(data[i:])
+		n42, err := m.Header.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n35
+		i += n42
 	}
 	if len(m.Token) > 0 {
 		data[i] = 0x12
@@ -5487,11 +6320,11 @@ func (m *AuthUserAddResponse) MarshalTo(data []byte) (int, error) {
 		data[i] = 0xa
 		i++
 		i = encodeVarintRpc(data, i, uint64(m.Header.Size()))
-		n36, err := m.Header.MarshalTo(data[i:])
+		n43, err := m.Header.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n36
+		i += n43
 	}
 	return i, nil
 }
@@ -5515,11 +6348,11 @@ func (m *AuthUserGetResponse) MarshalTo(data []byte) (int, error) {
 		data[i] = 0xa
 		i++
 		i = encodeVarintRpc(data, i, uint64(m.Header.Size()))
-		n37, err := m.Header.MarshalTo(data[i:])
+		n44, err := m.Header.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n37
+		i += n44
 	}
 	return i, nil
 }
@@ -5543,11 +6376,11 @@ func (m *AuthUserDeleteResponse) MarshalTo(data []byte) (int, error) {
 		data[i] = 0xa
 		i++
 		i = encodeVarintRpc(data, i, uint64(m.Header.Size()))
-		n38, err := m.Header.MarshalTo(data[i:])
+		n45, err := m.Header.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n38
+		i += n45
 	}
 	return i, nil
 }
@@ -5571,11 +6404,11 @@ func (m *AuthUserChangePasswordResponse) MarshalTo(data []byte) (int, error) {
 		data[i] = 0xa
 		i++
 		i = encodeVarintRpc(data, i, uint64(m.Header.Size()))
-		n39, err := m.Header.MarshalTo(data[i:])
+		n46, err := m.Header.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n39
+		i += n46
 	}
 	return i, nil
 }
@@ -5599,11 +6432,11 @@ func (m *AuthUserGrantResponse) MarshalTo(data []byte) (int, error) {
 		data[i] = 0xa
 		i++
 		i = encodeVarintRpc(data, i, uint64(m.Header.Size()))
-		n40, err := m.Header.MarshalTo(data[i:])
+		n47, err := m.Header.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n40
+		i += n47
 	}
 	return i, nil
 }
@@ -5627,11 +6460,11 @@ func (m *AuthUserRevokeResponse) MarshalTo(data []byte) (int, error) {
 		data[i] = 0xa
 		i++
 		i = encodeVarintRpc(data, i, uint64(m.Header.Size()))
-		n41, err := m.Header.MarshalTo(data[i:])
+		n48, err := m.Header.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n41
+		i += n48
 	}
 	return i, nil
 }
@@ -5655,11 +6488,11 @@ func (m *AuthRoleAddResponse) MarshalTo(data []byte) (int, error) {
 		data[i] = 0xa
 		i++
 		i = encodeVarintRpc(data, i, uint64(m.Header.Size()))
-		n42, err := m.Header.MarshalTo(data[i:])
+		n49, err := m.Header.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n42
+		i += n49
 	}
 	return i, nil
 }
@@ -5683,11 +6516,11 @@ func (m *AuthRoleGetResponse) MarshalTo(data []byte) (int, error) {
 		data[i] = 0xa
 		i++
 		i = encodeVarintRpc(data, i, uint64(m.Header.Size()))
-		n43, err := m.Header.MarshalTo(data[i:])
+		n50, err := m.Header.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n43
+		i += n50
 	}
 	return i, nil
 }
@@ -5711,11 +6544,11 @@ func (m *AuthRoleDeleteResponse) MarshalTo(data []byte) (int, error) {
 		data[i] = 0xa
 		i++
 		i = encodeVarintRpc(data, i, uint64(m.Header.Size()))
-		n44, err := m.Header.MarshalTo(data[i:])
+		n51, err := m.Header.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n44
+		i += n51
 	}
 	return i, nil
 }
@@ -5739,11 +6572,11 @@ func (m *AuthRoleGrantResponse) MarshalTo(data []byte) (int, error) {
 		data[i] = 0xa
 		i++
 		i = encodeVarintRpc(data, i, uint64(m.Header.Size()))
-		n45, err := m.Header.MarshalTo(data[i:])
+		n52, err := m.Header.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n45
+		i += n52
 	}
 	return i, nil
 }
@@ -5767,11 +6600,11 @@ func (m *AuthRoleRevokeResponse) MarshalTo(data []byte) (int, error) {
 		data[i] = 0xa
 		i++
 		i = encodeVarintRpc(data, i, uint64(m.Header.Size()))
-		n46, err := m.Header.MarshalTo(data[i:])
+		n53, err := m.Header.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n46
+		i += n53
 	}
 	return i, nil
 }
@@ -5847,6 +6680,24 @@ func (m *RangeRequest) Size() (n int) {
 	if m.Serializable {
 		n += 2
 	}
+	if m.CountOnly {
+		n += 2
+	}
+	if m.KeysOnly {
+		n += 2
+	}
+	if m.MinCreateRevision != 0 {
+		n += 1 + sovRpc(uint64(m.MinCreateRevision))
+	}
+	if m.MaxCreateRevision != 0 {
+		n += 1 + sovRpc(uint64(m.MaxCreateRevision))
+	}
+	if m.MinModRevision != 0 {
+		n += 1 + sovRpc(uint64(m.MinModRevision))
+	}
+	if m.MaxModRevision != 0 {
+		n += 1 + sovRpc(uint64(m.MaxModRevision))
+	}
 	return n
 }
 
@@ -5866,6 +6717,9 @@ func (m *RangeResponse) Size() (n int) {
 	if m.More {
 		n += 2
 	}
+	if m.Count != 0 {
+		n += 1 + sovRpc(uint64(m.Count))
+	}
 	return n
 }
 
@@ -5883,6 +6737,15 @@ func (m *PutRequest) Size() (n int) {
 	if m.Lease != 0 {
 		n += 1 + sovRpc(uint64(m.Lease))
 	}
+	if m.IgnoreValue {
+		n += 2
+	}
+	if m.IgnoreLease {
+		n += 2
+	}
+	if m.PrevKv {
+		n += 2
+	}
 	return n
 }
 
@@ -5893,6 +6756,10 @@ func (m *PutResponse) Size() (n int) {
 		l = m.Header.Size()
 		n += 1 + l + sovRpc(uint64(l))
 	}
+	if m.PrevKv != nil {
+		l = m.PrevKv.Size()
+		n += 1 + l + sovRpc(uint64(l))
+	}
 	return n
 }
 
@@ -5907,6 +6774,9 @@ func (m *DeleteRangeRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRpc(uint64(l))
 	}
+	if m.PrevKv {
+		n += 2
+	}
 	return n
 }
 
@@ -5920,6 +6790,12 @@ func (m *DeleteRangeResponse) Size() (n int) {
 	if m.Deleted != 0 {
 		n += 1 + sovRpc(uint64(m.Deleted))
 	}
+	if len(m.PrevKvs) > 0 {
+		for _, e := range m.PrevKvs {
+			l = e.Size()
+			n += 1 + l + sovRpc(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -6175,6 +7051,15 @@ func (m *WatchRequest_CancelRequest) Size() (n int) {
 	}
 	return n
 }
+func (m *WatchRequest_ProgressRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.ProgressRequest != nil {
+		l = m.ProgressRequest.Size()
+		n += 1 + l + sovRpc(uint64(l))
+	}
+	return n
+}
 func (m *WatchCreateRequest) Size() (n int) {
 	var l int
 	_ = l
@@ -6192,6 +7077,16 @@ func (m *WatchCreateRequest) Size() (n int) {
 	if m.ProgressNotify {
 		n += 2
 	}
+	if len(m.Filters) > 0 {
+		l = 0
+		for _, e := range m.Filters {
+			l += sovRpc(uint64(e))
+		}
+		n += 1 + sovRpc(uint64(l)) + l
+	}
+	if m.PrevKv {
+		n += 2
+	}
 	return n
 }
 
@@ -6204,6 +7099,12 @@ func (m *WatchCancelRequest) Size() (n int) {
 	return n
 }
 
+func (m *WatchProgressRequest) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
 func (m *WatchResponse) Size() (n int) {
 	var l int
 	_ = l
@@ -6308,58 +7209,50 @@ func (m *LeaseKeepAliveResponse) Size() (n int) {
 	return n
 }
 
-func (m *Member) Size() (n int) {
+func (m *LeaseTimeToLiveRequest) Size() (n int) {
 	var l int
 	_ = l
 	if m.ID != 0 {
 		n += 1 + sovRpc(uint64(m.ID))
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovRpc(uint64(l))
-	}
-	if len(m.PeerURLs) > 0 {
-		for _, s := range m.PeerURLs {
-			l = len(s)
-			n += 1 + l + sovRpc(uint64(l))
-		}
-	}
-	if len(m.ClientURLs) > 0 {
-		for _, s := range m.ClientURLs {
-			l = len(s)
-			n += 1 + l + sovRpc(uint64(l))
-		}
+	if m.Keys {
+		n += 2
 	}
 	return n
 }
 
-func (m *MemberAddRequest) Size() (n int) {
+func (m *LeaseTimeToLiveResponse) Size() (n int) {
 	var l int
 	_ = l
-	if len(m.PeerURLs) > 0 {
-		for _, s := range m.PeerURLs {
-			l = len(s)
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovRpc(uint64(l))
+	}
+	if m.ID != 0 {
+		n += 1 + sovRpc(uint64(m.ID))
+	}
+	if m.TTL != 0 {
+		n += 1 + sovRpc(uint64(m.TTL))
+	}
+	if m.GrantedTTL != 0 {
+		n += 1 + sovRpc(uint64(m.GrantedTTL))
+	}
+	if len(m.Keys) > 0 {
+		for _, b := range m.Keys {
+			l = len(b)
 			n += 1 + l + sovRpc(uint64(l))
 		}
 	}
 	return n
 }
 
-func (m *MemberAddResponse) Size() (n int) {
+func (m *LeaseLeasesRequest) Size() (n int) {
 	var l int
 	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovRpc(uint64(l))
-	}
-	if m.Member != nil {
-		l = m.Member.Size()
-		n += 1 + l + sovRpc(uint64(l))
-	}
 	return n
 }
 
-func (m *MemberRemoveRequest) Size() (n int) {
+func (m *LeaseStatus) Size() (n int) {
 	var l int
 	_ = l
 	if m.ID != 0 {
@@ -6368,38 +7261,132 @@ func (m *MemberRemoveRequest) Size() (n int) {
 	return n
 }
 
-func (m *MemberRemoveResponse) Size() (n int) {
+func (m *LeaseLeasesResponse) Size() (n int) {
 	var l int
 	_ = l
 	if m.Header != nil {
 		l = m.Header.Size()
 		n += 1 + l + sovRpc(uint64(l))
 	}
+	if len(m.Leases) > 0 {
+		for _, e := range m.Leases {
+			l = e.Size()
+			n += 1 + l + sovRpc(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *MemberUpdateRequest) Size() (n int) {
+func (m *Member) Size() (n int) {
 	var l int
 	_ = l
 	if m.ID != 0 {
 		n += 1 + sovRpc(uint64(m.ID))
 	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovRpc(uint64(l))
+	}
 	if len(m.PeerURLs) > 0 {
 		for _, s := range m.PeerURLs {
 			l = len(s)
 			n += 1 + l + sovRpc(uint64(l))
 		}
 	}
-	return n
-}
-
-func (m *MemberUpdateResponse) Size() (n int) {
-	var l int
+	if len(m.ClientURLs) > 0 {
+		for _, s := range m.ClientURLs {
+			l = len(s)
+			n += 1 + l + sovRpc(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MemberAddRequest) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.PeerURLs) > 0 {
+		for _, s := range m.PeerURLs {
+			l = len(s)
+			n += 1 + l + sovRpc(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MemberAddResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovRpc(uint64(l))
+	}
+	if m.Member != nil {
+		l = m.Member.Size()
+		n += 1 + l + sovRpc(uint64(l))
+	}
+	if len(m.Members) > 0 {
+		for _, e := range m.Members {
+			l = e.Size()
+			n += 1 + l + sovRpc(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MemberRemoveRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovRpc(uint64(m.ID))
+	}
+	return n
+}
+
+func (m *MemberRemoveResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovRpc(uint64(l))
+	}
+	if len(m.Members) > 0 {
+		for _, e := range m.Members {
+			l = e.Size()
+			n += 1 + l + sovRpc(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MemberUpdateRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovRpc(uint64(m.ID))
+	}
+	if len(m.PeerURLs) > 0 {
+		for _, s := range m.PeerURLs {
+			l = len(s)
+			n += 1 + l + sovRpc(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MemberUpdateResponse) Size() (n int) {
+	var l int
 	_ = l
 	if m.Header != nil {
 		l = m.Header.Size()
 		n += 1 + l + sovRpc(uint64(l))
 	}
+	if len(m.Members) > 0 {
+		for _, e := range m.Members {
+			l = e.Size()
+			n += 1 + l + sovRpc(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -6441,6 +7428,25 @@ func (m *DefragmentResponse) Size() (n int) {
 	return n
 }
 
+func (m *MoveLeaderRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.TargetID != 0 {
+		n += 1 + sovRpc(uint64(m.TargetID))
+	}
+	return n
+}
+
+func (m *MoveLeaderResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovRpc(uint64(l))
+	}
+	return n
+}
+
 func (m *AlarmRequest) Size() (n int) {
 	var l int
 	_ = l
@@ -6603,6 +7609,14 @@ func (m *AuthUserGrantRequest) Size() (n int) {
 func (m *AuthUserRevokeRequest) Size() (n int) {
 	var l int
 	_ = l
+	l = len(m.User)
+	if l > 0 {
+		n += 1 + l + sovRpc(uint64(l))
+	}
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovRpc(uint64(l))
+	}
 	return n
 }
 
@@ -6625,6 +7639,10 @@ func (m *AuthRoleGetRequest) Size() (n int) {
 func (m *AuthRoleDeleteRequest) Size() (n int) {
 	var l int
 	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovRpc(uint64(l))
+	}
 	return n
 }
 
@@ -6645,6 +7663,18 @@ func (m *AuthRoleGrantRequest) Size() (n int) {
 func (m *AuthRoleRevokeRequest) Size() (n int) {
 	var l int
 	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovRpc(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovRpc(uint64(l))
+	}
+	l = len(m.RangeEnd)
+	if l > 0 {
+		n += 1 + l + sovRpc(uint64(l))
+	}
 	return n
 }
 
@@ -7118,6 +8148,122 @@ func (m *RangeRequest) Unmarshal(data []byte) error {
 				}
 			}
 			m.Serializable = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CountOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CountOnly = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeysOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.KeysOnly = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCreateRevision", wireType)
+			}
+			m.MinCreateRevision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.MinCreateRevision |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCreateRevision", wireType)
+			}
+			m.MaxCreateRevision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.MaxCreateRevision |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinModRevision", wireType)
+			}
+			m.MinModRevision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.MinModRevision |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxModRevision", wireType)
+			}
+			m.MaxModRevision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.MaxModRevision |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpc(data[iNdEx:])
@@ -7252,6 +8398,25 @@ func (m *RangeResponse) Unmarshal(data []byte) error {
 				}
 			}
 			m.More = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Count |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpc(data[iNdEx:])
@@ -7383,22 +8548,82 @@ func (m *PutRequest) Unmarshal(data []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRpc(data[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthRpc
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreValue", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IgnoreValue = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreLease", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IgnoreLease = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevKv", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PrevKv = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpc(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
@@ -7466,6 +8691,39 @@ func (m *PutResponse) Unmarshal(data []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevKv", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PrevKv == nil {
+				m.PrevKv = &mvccpb.KeyValue{}
+			}
+			if err := m.PrevKv.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpc(data[iNdEx:])
@@ -7578,6 +8836,26 @@ func (m *DeleteRangeRequest) Unmarshal(data []byte) error {
 				m.RangeEnd = []byte{}
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevKv", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PrevKv = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpc(data[iNdEx:])
@@ -7680,6 +8958,37 @@ func (m *DeleteRangeResponse) Unmarshal(data []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevKvs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrevKvs = append(m.PrevKvs, &mvccpb.KeyValue{})
+			if err := m.PrevKvs[len(m.PrevKvs)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpc(data[iNdEx:])
@@ -9079,6 +10388,38 @@ func (m *WatchRequest) Unmarshal(data []byte) error {
 			}
 			m.RequestUnion = &WatchRequest_CancelRequest{v}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgressRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &WatchProgressRequest{}
+			if err := v.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.RequestUnion = &WatchRequest_ProgressRequest{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpc(data[iNdEx:])
@@ -9230,6 +10571,88 @@ func (m *WatchCreateRequest) Unmarshal(data []byte) error {
 				}
 			}
 			m.ProgressNotify = bool(v != 0)
+		case 5:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRpc
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthRpc
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v WatchCreateRequest_FilterType
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRpc
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := data[iNdEx]
+						iNdEx++
+						v |= (WatchCreateRequest_FilterType(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Filters = append(m.Filters, v)
+				}
+			} else if wireType == 0 {
+				var v WatchCreateRequest_FilterType
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRpc
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[iNdEx]
+					iNdEx++
+					v |= (WatchCreateRequest_FilterType(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Filters = append(m.Filters, v)
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filters", wireType)
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevKv", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PrevKv = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpc(data[iNdEx:])
@@ -9320,7 +10743,7 @@ func (m *WatchCancelRequest) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *WatchResponse) Unmarshal(data []byte) error {
+func (m *WatchProgressRequest) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9343,45 +10766,95 @@ func (m *WatchResponse) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WatchResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: WatchProgressRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WatchProgressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpc
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpc(data[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthRpc
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Header == nil {
-				m.Header = &ResponseHeader{}
-			}
-			if err := m.Header.Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WatchResponse) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WatchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &ResponseHeader{}
+			}
+			if err := m.Header.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field WatchId", wireType)
@@ -10092,7 +11565,7 @@ func (m *LeaseKeepAliveResponse) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *Member) Unmarshal(data []byte) error {
+func (m *LeaseTimeToLiveRequest) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10115,10 +11588,10 @@ func (m *Member) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Member: wiretype end group for non-group")
+			return fmt.Errorf("proto: LeaseTimeToLiveRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Member: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LeaseTimeToLiveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10135,16 +11608,16 @@ func (m *Member) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.ID |= (uint64(b) & 0x7F) << shift
+				m.ID |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpc
@@ -10154,26 +11627,67 @@ func (m *Member) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			m.Keys = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpc(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthRpc
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(data[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LeaseTimeToLiveResponse) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LeaseTimeToLiveResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LeaseTimeToLiveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeerURLs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpc
@@ -10183,26 +11697,87 @@ func (m *Member) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRpc
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PeerURLs = append(m.PeerURLs, string(data[iNdEx:postIndex]))
+			if m.Header == nil {
+				m.Header = &ResponseHeader{}
+			}
+			if err := m.Header.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.ID |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TTL", wireType)
+			}
+			m.TTL = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.TTL |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GrantedTTL", wireType)
+			}
+			m.GrantedTTL = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.GrantedTTL |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientURLs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpc
@@ -10212,20 +11787,20 @@ func (m *Member) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRpc
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientURLs = append(m.ClientURLs, string(data[iNdEx:postIndex]))
+			m.Keys = append(m.Keys, make([]byte, postIndex-iNdEx))
+			copy(m.Keys[len(m.Keys)-1], data[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10248,7 +11823,7 @@ func (m *Member) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *MemberAddRequest) Unmarshal(data []byte) error {
+func (m *LeaseLeasesRequest) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10271,41 +11846,12 @@ func (m *MemberAddRequest) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MemberAddRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: LeaseLeasesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MemberAddRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LeaseLeasesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeerURLs", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpc
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRpc
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PeerURLs = append(m.PeerURLs, string(data[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpc(data[iNdEx:])
@@ -10327,7 +11873,7 @@ func (m *MemberAddRequest) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *MemberAddResponse) Unmarshal(data []byte) error {
+func (m *LeaseStatus) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10350,15 +11896,433 @@ func (m *MemberAddResponse) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MemberAddResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: LeaseStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MemberAddResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LeaseStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.ID |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpc(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LeaseLeasesResponse) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LeaseLeasesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LeaseLeasesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &ResponseHeader{}
+			}
+			if err := m.Header.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leases", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Leases = append(m.Leases, &LeaseStatus{})
+			if err := m.Leases[len(m.Leases)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpc(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Member) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Member: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Member: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.ID |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerURLs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeerURLs = append(m.PeerURLs, string(data[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientURLs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientURLs = append(m.ClientURLs, string(data[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpc(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MemberAddRequest) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MemberAddRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MemberAddRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerURLs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeerURLs = append(m.PeerURLs, string(data[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpc(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MemberAddResponse) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MemberAddResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MemberAddResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10422,6 +12386,37 @@ func (m *MemberAddResponse) Unmarshal(data []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, &Member{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpc(data[iNdEx:])
@@ -10574,6 +12569,37 @@ func (m *MemberRemoveResponse) Unmarshal(data []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, &Member{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpc(data[iNdEx:])
@@ -10724,7 +12750,204 @@ func (m *MemberUpdateResponse) Unmarshal(data []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &ResponseHeader{}
+			}
+			if err := m.Header.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, &Member{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpc(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MemberListRequest) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MemberListRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MemberListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpc(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MemberListResponse) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MemberListResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MemberListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &ResponseHeader{}
+			}
+			if err := m.Header.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10748,10 +12971,8 @@ func (m *MemberUpdateResponse) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Header == nil {
-				m.Header = &ResponseHeader{}
-			}
-			if err := m.Header.Unmarshal(data[iNdEx:postIndex]); err != nil {
+			m.Members = append(m.Members, &Member{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10776,7 +12997,7 @@ func (m *MemberUpdateResponse) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *MemberListRequest) Unmarshal(data []byte) error {
+func (m *DefragmentRequest) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10799,10 +13020,10 @@ func (m *MemberListRequest) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MemberListRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DefragmentRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MemberListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DefragmentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -10826,7 +13047,7 @@ func (m *MemberListRequest) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *MemberListResponse) Unmarshal(data []byte) error {
+func (m *DefragmentResponse) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10849,10 +13070,10 @@ func (m *MemberListResponse) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MemberListResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DefragmentResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MemberListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DefragmentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10888,37 +13109,6 @@ func (m *MemberListResponse) Unmarshal(data []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpc
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRpc
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Members = append(m.Members, &Member{})
-			if err := m.Members[len(m.Members)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpc(data[iNdEx:])
@@ -10940,7 +13130,7 @@ func (m *MemberListResponse) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *DefragmentRequest) Unmarshal(data []byte) error {
+func (m *MoveLeaderRequest) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10963,12 +13153,31 @@ func (m *DefragmentRequest) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DefragmentRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MoveLeaderRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DefragmentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MoveLeaderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetID", wireType)
+			}
+			m.TargetID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.TargetID |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpc(data[iNdEx:])
@@ -10990,7 +13199,7 @@ func (m *DefragmentRequest) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *DefragmentResponse) Unmarshal(data []byte) error {
+func (m *MoveLeaderResponse) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11013,10 +13222,10 @@ func (m *DefragmentResponse) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DefragmentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MoveLeaderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DefragmentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MoveLeaderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -12310,6 +14519,64 @@ func (m *AuthUserRevokeRequest) Unmarshal(data []byte) error {
 			return fmt.Errorf("proto: AuthUserRevokeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.User = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpc(data[iNdEx:])
@@ -12489,6 +14756,35 @@ func (m *AuthRoleDeleteRequest) Unmarshal(data []byte) error {
 			return fmt.Errorf("proto: AuthRoleDeleteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpc(data[iNdEx:])
@@ -12651,6 +14947,93 @@ func (m *AuthRoleRevokeRequest) Unmarshal(data []byte) error {
 			return fmt.Errorf("proto: AuthRoleRevokeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RangeEnd", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RangeEnd = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpc(data[iNdEx:])
@@ -13969,166 +16352,193 @@ var (
 )
 
 var fileDescriptorRpc = []byte{
-	// 2576 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xb4, 0x5a, 0xcd, 0x72, 0x1b, 0xc7,
-	0x11, 0x26, 0x7e, 0x08, 0x10, 0x0d, 0x10, 0xa2, 0x86, 0x94, 0x4c, 0x41, 0xb6, 0x2c, 0xaf, 0x24,
-	0x5b, 0x89, 0x1d, 0x2a, 0x66, 0x9c, 0x43, 0x2a, 0x2e, 0xa5, 0x40, 0x02, 0x96, 0x68, 0x52, 0xa4,
-	0xbc, 0x04, 0xa9, 0xf8, 0xc4, 0x5a, 0x02, 0x23, 0x12, 0x25, 0xfc, 0x79, 0x77, 0x41, 0x89, 0xaa,
-	0xca, 0x25, 0x55, 0x79, 0x02, 0xe7, 0x94, 0xca, 0x0b, 0xe4, 0x01, 0xf2, 0x0e, 0xa9, 0x5c, 0x92,
-	0x27, 0x48, 0x52, 0x39, 0xa5, 0x72, 0xc9, 0x3d, 0xb9, 0xa4, 0xe7, 0x77, 0x67, 0x07, 0xbb, 0x94,
-	0x9c, 0x65, 0x0e, 0x22, 0x77, 0x7a, 0xba, 0xbf, 0xe9, 0xee, 0xe9, 0xe9, 0xe9, 0x1e, 0x0a, 0x2a,
-	0xfe, 0xa4, 0xbb, 0x36, 0xf1, 0xc7, 0xe1, 0x98, 0xd4, 0x68, 0xd8, 0xed, 0x05, 0xd4, 0x3f, 0xa3,
-	0xfe, 0xe4, 0xb8, 0xb1, 0x72, 0x32, 0x3e, 0x19, 0xf3, 0x89, 0x07, 0xec, 0x4b, 0xf0, 0x34, 0x6e,
-	0x30, 0x9e, 0x07, 0xc3, 0xb3, 0x6e, 0x97, 0xff, 0x98, 0x1c, 0x3f, 0x78, 0x71, 0x26, 0xa7, 0x6e,
-	0xf2, 0x29, 0x6f, 0x1a, 0x9e, 0xf2, 0x1f, 0x38, 0xc5, 0x7e, 0x89, 0x49, 0xe7, 0x57, 0x39, 0xa8,
-	0xbb, 0x34, 0x98, 0x8c, 0x47, 0x01, 0x7d, 0x4c, 0xbd, 0x1e, 0xf5, 0xc9, 0x7b, 0x00, 0xdd, 0xc1,
-	0x34, 0x08, 0xa9, 0x7f, 0xd4, 0xef, 0xad, 0xe6, 0x6e, 0xe7, 0xee, 0x17, 0xdd, 0x8a, 0xa4, 0x6c,
-	0xf5, 0xc8, 0x4d, 0xa8, 0x0c, 0xe9, 0xf0, 0x58, 0xcc, 0xe6, 0xf9, 0xec, 0x82, 0x20, 0xe0, 0x64,
-	0x03, 0x16, 0x7c, 0x7a, 0xd6, 0x0f, 0xfa, 0xe3, 0xd1, 0x6a, 0x01, 0xe7, 0x0a, 0xae, 0x1e, 0x33,
-	0x41, 0xdf, 0x7b, 0x1e, 0x1e, 0x21, 0xcc, 0x70, 0xb5, 0x28, 0x04, 0x19, 0xa1, 0x83, 0x63, 0xe7,
-	0xd7, 0x05, 0xa8, 0xb9, 0xde, 0xe8, 0x84, 0xba, 0xf4, 0x9b, 0x29, 0x0d, 0x42, 0xb2, 0x04, 0x85,
-	0x17, 0xf4, 0x9c, 0x2f, 0x5f, 0x73, 0xd9, 0xa7, 0x90, 0x47, 0x8e, 0x23, 0x3a, 0x12, 0x0b, 0xd7,
-	0x98, 0x3c, 0x12, 0xda, 0xa3, 0x1e, 0x59, 0x81, 0xf9, 0x41, 0x7f, 0xd8, 0x0f, 0xe5, 0xaa, 0x62,
-	0x10, 0x53, 0xa7, 0x68, 0xa9, 0xb3, 0x09, 0x10, 0x8c, 0xfd, 0xf0, 0x68, 0xec, 0xa3, 0xd1, 0xab,
-	0xf3, 0x38, 0x5b, 0x5f, 0xbf, 0xbb, 0x66, 0xba, 0x7a, 0xcd, 0x54, 0x68, 0x6d, 0x1f, 0x99, 0xf7,
-	0x18, 0xaf, 0x5b, 0x09, 0xd4, 0x27, 0xf9, 0x02, 0xaa, 0x1c, 0x24, 0xf4, 0xfc, 0x13, 0x1a, 0xae,
-	0x96, 0x38, 0xca, 0xbd, 0x37, 0xa0, 0x74, 0x38, 0xb3, 0xcb, 0x97, 0x17, 0xdf, 0xc4, 0x81, 0x1a,
-	0xf2, 0xf7, 0xbd, 0x41, 0xff, 0xb5, 0x77, 0x3c, 0xa0, 0xab, 0x65, 0x04, 0x5a, 0x70, 0x63, 0x34,
-	0x67, 0x0d, 0x2a, 0x5a, 0x07, 0xb2, 0x00, 0xc5, 0xdd, 0xbd, 0xdd, 0xf6, 0xd2, 0x1c, 0x01, 0x28,
-	0x35, 0xf7, 0x37, 0xdb, 0xbb, 0xad, 0xa5, 0x1c, 0xa9, 0x42, 0xb9, 0xd5, 0x16, 0x83, 0xbc, 0xb3,
-	0x01, 0x10, 0xad, 0x46, 0xca, 0x50, 0xd8, 0x6e, 0x7f, 0x8d, 0xfc, 0xc8, 0x73, 0xd8, 0x76, 0xf7,
-	0xb7, 0xf6, 0x76, 0x51, 0x00, 0x85, 0x37, 0xdd, 0x76, 0xb3, 0xd3, 0x5e, 0xca, 0x33, 0x8e, 0x27,
-	0x7b, 0xad, 0xa5, 0x02, 0xa9, 0xc0, 0xfc, 0x61, 0x73, 0xe7, 0xa0, 0xbd, 0x54, 0x74, 0x7e, 0x01,
-	0x8b, 0x52, 0x7d, 0x11, 0x22, 0xe4, 0x33, 0x28, 0x9d, 0xf2, 0x30, 0xe1, 0x3b, 0x53, 0x5d, 0x7f,
-	0xd7, 0xb2, 0x35, 0x16, 0x4a, 0xae, 0xe4, 0x45, 0xf3, 0x0a, 0x2f, 0xce, 0x02, 0xdc, 0xb4, 0x02,
-	0x8a, 0x2c, 0xad, 0x89, 0x08, 0x5d, 0xdb, 0xa6, 0xe7, 0x87, 0xde, 0x60, 0x4a, 0x5d, 0x36, 0x49,
-	0x08, 0x14, 0x87, 0x63, 0x9f, 0xf2, 0x0d, 0x5c, 0x70, 0xf9, 0xb7, 0xf3, 0x25, 0xc0, 0xd3, 0x69,
-	0x98, 0x1e, 0x12, 0xb8, 0xeb, 0x67, 0x0c, 0x41, 0x86, 0x83, 0x18, 0xf0, 0x58, 0xa0, 0x5e, 0x40,
-	0x75, 0x2c, 0xb0, 0x81, 0xb3, 0x09, 0x55, 0x8e, 0x95, 0xc5, 0x10, 0x04, 0x21, 0x2d, 0x3a, 0xa0,
-	0x21, 0xcd, 0x10, 0xab, 0x0e, 0x85, 0xe5, 0x18, 0x48, 0x26, 0xd7, 0xae, 0x42, 0xb9, 0xc7, 0xc1,
-	0xc4, 0x3a, 0x05, 0x57, 0x0d, 0x9d, 0x7f, 0xe5, 0xf0, 0x48, 0x09, 0x0d, 0x0f, 0x46, 0x2c, 0xe2,
-	0x9b, 0xb0, 0xe8, 0x8b, 0xf1, 0x11, 0xd7, 0x45, 0xae, 0xd3, 0x48, 0x0f, 0xd7, 0xc7, 0x73, 0x6e,
-	0x4d, 0x8a, 0x70, 0x32, 0xf9, 0x29, 0x54, 0x15, 0xc4, 0x64, 0x1a, 0xf2, 0x15, 0xab, 0xeb, 0xab,
-	0x71, 0x80, 0x68, 0xc7, 0x50, 0x1c, 0x24, 0x3b, 0x12, 0x49, 0x07, 0x56, 0x94, 0xb0, 0xd0, 0x51,
-	0xaa, 0x51, 0xe0, 0x28, 0xb7, 0xe3, 0x28, 0xb3, 0x6e, 0x46, 0x34, 0x22, 0xe5, 0x8d, 0xc9, 0x8d,
-	0x0a, 0x94, 0x25, 0xd5, 0xf9, 0x77, 0x0e, 0xc3, 0x55, 0xba, 0x49, 0x98, 0xdc, 0x82, 0xba, 0x2f,
-	0x09, 0x31, 0x9b, 0x6f, 0x26, 0xda, 0x2c, 0x1d, 0x3c, 0xe7, 0x2e, 0x2a, 0x21, 0x61, 0xf5, 0x43,
-	0xa8, 0x69, 0x94, 0xc8, 0xec, 0x1b, 0x09, 0x66, 0x6b, 0x84, 0xaa, 0x12, 0x60, 0x86, 0x3f, 0x83,
-	0x6b, 0x5a, 0x3e, 0xc1, 0xf2, 0x0f, 0x2e, 0xb0, 0x5c, 0x03, 0x2e, 0x2b, 0x04, 0xd3, 0x76, 0x60,
-	0xf9, 0x4d, 0x90, 0x9d, 0xdf, 0x14, 0xa0, 0xbc, 0x39, 0x1e, 0x4e, 0x3c, 0x9f, 0x6d, 0x53, 0x09,
-	0xe9, 0xd3, 0x41, 0xc8, 0xcd, 0xad, 0xaf, 0xdf, 0x89, 0xaf, 0x20, 0xd9, 0xd4, 0x6f, 0x97, 0xb3,
-	0xba, 0x52, 0x84, 0x09, 0xcb, 0x74, 0x96, 0x7f, 0x0b, 0x61, 0x99, 0xcc, 0xa4, 0x88, 0x3a, 0x0a,
-	0x85, 0xe8, 0x28, 0x34, 0xa0, 0x8c, 0x82, 0x51, 0x0a, 0x46, 0x5b, 0x14, 0x81, 0x7c, 0x0f, 0xae,
-	0x74, 0x7d, 0xea, 0x31, 0x7f, 0xa8, 0x34, 0x3d, 0x2f, 0x79, 0xea, 0x62, 0xc2, 0x55, 0xe9, 0xfa,
-	0x0e, 0xd4, 0x86, 0xe3, 0x5e, 0xc4, 0x57, 0x92, 0x7c, 0x55, 0xa4, 0x6a, 0xa6, 0xeb, 0x2a, 0x1f,
-	0xb0, 0xfc, 0x59, 0xc3, 0x59, 0x31, 0x74, 0x3e, 0x85, 0xc5, 0x98, 0xad, 0x2c, 0xc5, 0xb5, 0xbf,
-	0x3a, 0x68, 0xee, 0x88, 0x7c, 0xf8, 0x88, 0xa7, 0x40, 0x17, 0xf3, 0x21, 0xa6, 0xd5, 0x9d, 0xf6,
-	0xfe, 0x3e, 0x66, 0xcf, 0xcf, 0xb5, 0x88, 0x4c, 0xa0, 0x46, 0xde, 0x9c, 0x33, 0xf2, 0x66, 0x4e,
-	0xe5, 0xcd, 0x7c, 0x94, 0x37, 0x0b, 0x1b, 0x75, 0xa8, 0x09, 0x87, 0x1c, 0x4d, 0x59, 0x1c, 0x3a,
-	0xbf, 0xcb, 0x01, 0x74, 0x5e, 0x8d, 0x54, 0xc2, 0x78, 0x00, 0xe5, 0xae, 0x00, 0xc7, 0x0d, 0x62,
-	0x39, 0xf1, 0x5a, 0xa2, 0x8f, 0x5d, 0xc5, 0x85, 0xb9, 0xa1, 0x1c, 0x4c, 0xbb, 0x5d, 0x1a, 0xa8,
-	0x24, 0x6a, 0x1f, 0x5a, 0xe3, 0x9c, 0xbb, 0x8a, 0x95, 0x49, 0x3d, 0xf7, 0xfa, 0x83, 0x29, 0xcf,
-	0xaa, 0x6f, 0x94, 0x92, 0xac, 0xce, 0x6f, 0x73, 0x50, 0xe5, 0xba, 0x66, 0xca, 0x4b, 0xef, 0x42,
-	0x85, 0xab, 0x41, 0x7b, 0x32, 0x33, 0x2d, 0xb8, 0x11, 0x81, 0xfc, 0x04, 0xf3, 0xa3, 0x94, 0x0b,
-	0xa4, 0x6e, 0x37, 0x93, 0x61, 0x85, 0x72, 0x11, 0xb7, 0xb3, 0x0d, 0x57, 0xb9, 0x7b, 0xba, 0x21,
-	0x9b, 0x90, 0x0e, 0x35, 0x2f, 0xfa, 0x9c, 0x75, 0xd1, 0xe3, 0xdc, 0xe4, 0xf4, 0x3c, 0xe8, 0x77,
-	0xbd, 0x81, 0x54, 0x44, 0x8f, 0xf1, 0x82, 0x21, 0x26, 0x58, 0xa6, 0xbb, 0x61, 0x11, 0xaa, 0x8f,
-	0xbd, 0xe0, 0x54, 0xaa, 0xe4, 0xfc, 0x1c, 0x6a, 0x62, 0x98, 0xc9, 0x8d, 0x78, 0x2b, 0x9e, 0x22,
-	0x0a, 0x57, 0x7c, 0xd1, 0xe5, 0xdf, 0xce, 0x55, 0xb8, 0xb2, 0x3f, 0xf2, 0x26, 0xc1, 0xe9, 0x58,
-	0x25, 0x5a, 0x56, 0xc6, 0x2d, 0x45, 0xb4, 0x4c, 0x2b, 0x7e, 0x04, 0x57, 0x7c, 0x3a, 0xf4, 0xfa,
-	0xa3, 0xfe, 0xe8, 0xe4, 0xe8, 0xf8, 0x3c, 0xa4, 0x81, 0xac, 0xf2, 0xea, 0x9a, 0xbc, 0xc1, 0xa8,
-	0x4c, 0xb5, 0xe3, 0xc1, 0xf8, 0x58, 0x9e, 0x75, 0xfe, 0xed, 0xfc, 0x1e, 0xef, 0x9c, 0x67, 0x5e,
-	0xd8, 0x55, 0x5e, 0x20, 0x5b, 0x50, 0xd7, 0x27, 0x9c, 0x53, 0xa4, 0x2e, 0x56, 0xb6, 0xe7, 0x32,
-	0x9b, 0xf2, 0xc4, 0xab, 0x6c, 0xbf, 0xd8, 0x35, 0x09, 0x1c, 0xca, 0x1b, 0x75, 0xe9, 0x40, 0x43,
-	0xe5, 0xd3, 0xa1, 0x38, 0xa3, 0x09, 0x65, 0x12, 0x36, 0xae, 0x44, 0x37, 0xa1, 0x38, 0x9f, 0xdf,
-	0xe6, 0x80, 0xcc, 0xea, 0xf0, 0x5d, 0x8b, 0xd0, 0x7b, 0x50, 0x0f, 0xf0, 0xd8, 0x87, 0x47, 0x56,
-	0x0d, 0xbc, 0xc8, 0xa9, 0x3a, 0x4b, 0xa1, 0x87, 0xb1, 0xf8, 0x3e, 0xc1, 0x90, 0x0e, 0x8e, 0x46,
-	0xe3, 0xb0, 0xff, 0xfc, 0x9c, 0x67, 0xc6, 0x05, 0xb7, 0xae, 0xc8, 0xbb, 0x9c, 0xea, 0x3c, 0x50,
-	0x4a, 0x99, 0xca, 0x93, 0x1b, 0xb0, 0xf0, 0x92, 0x51, 0x55, 0x75, 0x8e, 0x57, 0x3e, 0x1f, 0x6f,
-	0xf5, 0x9c, 0x7f, 0xe0, 0x05, 0x28, 0xdd, 0x9f, 0x29, 0x06, 0xcc, 0x25, 0xf2, 0xb1, 0x25, 0x58,
-	0xbd, 0x21, 0xb6, 0xa5, 0x27, 0x2b, 0x35, 0x35, 0x64, 0xe7, 0x4c, 0x78, 0x19, 0xa7, 0x84, 0x3d,
-	0x7a, 0x8c, 0x89, 0x7e, 0xa9, 0x2b, 0xce, 0x99, 0x95, 0xe9, 0xdd, 0x2b, 0x92, 0xae, 0xbd, 0x73,
-	0x0f, 0x4a, 0xf4, 0x8c, 0x8e, 0xc2, 0x60, 0xb5, 0xca, 0xf3, 0xc2, 0xa2, 0x2a, 0x17, 0xdb, 0x8c,
-	0xea, 0xca, 0x49, 0xe7, 0xc7, 0x70, 0x75, 0x87, 0xd5, 0x75, 0x8f, 0xd0, 0xfb, 0x66, 0x85, 0xd8,
-	0xe9, 0xec, 0x48, 0xaf, 0x14, 0xc2, 0xce, 0x0e, 0xa9, 0x43, 0x7e, 0xab, 0x25, 0x6d, 0xc8, 0xf7,
-	0x5b, 0xce, 0x2f, 0x71, 0xa3, 0x4d, 0xb9, 0x4c, 0x6e, 0xb2, 0xc0, 0xd5, 0xf2, 0x85, 0x68, 0x79,
-	0x2c, 0x45, 0xa9, 0xef, 0x8f, 0x7d, 0xee, 0x90, 0x8a, 0x2b, 0x06, 0xce, 0x5d, 0xa9, 0x03, 0xda,
-	0x3c, 0x7e, 0xa1, 0x83, 0x4d, 0xa0, 0xe5, 0xb4, 0xaa, 0xdb, 0xb0, 0x1c, 0xe3, 0xca, 0x94, 0x9c,
-	0x3e, 0x82, 0x6b, 0x1c, 0x6c, 0x9b, 0xd2, 0x49, 0x73, 0xd0, 0x3f, 0x4b, 0x5d, 0x75, 0x02, 0xd7,
-	0x6d, 0xc6, 0xff, 0xaf, 0x8f, 0x9c, 0x53, 0x28, 0x3d, 0xe1, 0xfd, 0xa3, 0xa1, 0x4b, 0x91, 0xf3,
-	0x62, 0x86, 0x19, 0x79, 0x43, 0x51, 0xdd, 0x57, 0x5c, 0xfe, 0xcd, 0xb3, 0x39, 0xa5, 0xfe, 0x81,
-	0xbb, 0x23, 0x2e, 0x8e, 0x8a, 0xab, 0xc7, 0xe4, 0x16, 0xeb, 0x5c, 0xfb, 0x18, 0x1e, 0x7c, 0xb6,
-	0xc8, 0x67, 0x0d, 0x0a, 0x76, 0x50, 0x4b, 0x62, 0xa5, 0x66, 0xaf, 0x67, 0xdc, 0x1c, 0x1a, 0x2f,
-	0x17, 0xc7, 0x73, 0x5e, 0xc2, 0x55, 0x83, 0x3f, 0x93, 0x1b, 0x3e, 0x81, 0x92, 0x68, 0x92, 0x65,
-	0xd2, 0x5a, 0x89, 0x4b, 0x89, 0x65, 0x5c, 0xc9, 0xe3, 0xdc, 0x83, 0x65, 0x49, 0xa1, 0xc3, 0x71,
-	0xd2, 0x5e, 0x71, 0xff, 0x38, 0x3b, 0xb0, 0x12, 0x67, 0xcb, 0x14, 0x22, 0x4d, 0xb5, 0xe8, 0xc1,
-	0xa4, 0x67, 0xe4, 0x40, 0x7b, 0x53, 0x4c, 0x87, 0xe5, 0x2d, 0x87, 0x69, 0x85, 0x14, 0x44, 0x26,
-	0x85, 0x96, 0x95, 0xfb, 0x77, 0xfa, 0x81, 0xbe, 0xe9, 0x5e, 0x03, 0x31, 0x89, 0x99, 0x36, 0x65,
-	0x0d, 0xca, 0xc2, 0xe1, 0xaa, 0xaa, 0x4a, 0xde, 0x15, 0xc5, 0xc4, 0x14, 0x6a, 0xd1, 0xe7, 0xbe,
-	0x77, 0x32, 0xa4, 0x3a, 0xe7, 0xb0, 0x12, 0xc2, 0x24, 0x66, 0xb2, 0xf8, 0x4f, 0x78, 0x7d, 0x36,
-	0x07, 0x9e, 0x3f, 0x54, 0xce, 0x7f, 0x08, 0x25, 0x51, 0x9b, 0xc8, 0x42, 0xfe, 0xc3, 0x38, 0x8c,
-	0xc9, 0x2b, 0x06, 0x4d, 0x51, 0xc9, 0x48, 0x29, 0xb6, 0x59, 0xf2, 0x6d, 0xa6, 0x65, 0xbd, 0xd5,
-	0xb4, 0xc8, 0x0f, 0x60, 0xde, 0x63, 0x22, 0xfc, 0x2c, 0xd6, 0xd7, 0xdf, 0x49, 0x80, 0xee, 0x9c,
-	0x4f, 0xa8, 0x2b, 0xb8, 0x9c, 0xcf, 0xa0, 0x6a, 0xac, 0xc0, 0xaa, 0xde, 0x47, 0xed, 0x0e, 0x96,
-	0xc2, 0x35, 0x58, 0x68, 0x6e, 0x76, 0xb6, 0x0e, 0x45, 0x31, 0x5c, 0x07, 0x68, 0xb5, 0xf5, 0x38,
-	0x8f, 0x55, 0x90, 0x90, 0x92, 0x27, 0xdc, 0xd4, 0x27, 0x97, 0xa6, 0x4f, 0xfe, 0xad, 0xf4, 0x79,
-	0x05, 0x8b, 0xd2, 0xfc, 0x4c, 0x31, 0xf0, 0x29, 0x7a, 0x98, 0xc1, 0xa8, 0x10, 0xb8, 0x91, 0xb0,
-	0xac, 0x3a, 0x9d, 0x82, 0xd1, 0xc1, 0xea, 0x61, 0x3f, 0xf4, 0xc2, 0x69, 0xa0, 0x42, 0xe0, 0x8f,
-	0x39, 0xa8, 0x2b, 0x4a, 0xd6, 0x66, 0x5e, 0xf5, 0x4a, 0x22, 0xe7, 0xe9, 0x4e, 0xe9, 0x3a, 0x94,
-	0x7a, 0xc7, 0xfb, 0xfd, 0xd7, 0xea, 0x51, 0x43, 0x8e, 0x18, 0x7d, 0x20, 0xd6, 0x11, 0x2f, 0x6a,
-	0x72, 0xc4, 0xca, 0x6f, 0xf6, 0xb6, 0xb6, 0x35, 0xea, 0xd1, 0x57, 0xfc, 0xa6, 0x2d, 0xba, 0x11,
-	0x81, 0x97, 0xcb, 0xf2, 0xe5, 0x8d, 0x37, 0x52, 0xe6, 0x4b, 0x1c, 0x06, 0x79, 0x73, 0x1a, 0x9e,
-	0xb6, 0x47, 0xec, 0xd1, 0x49, 0x59, 0xb8, 0x02, 0x84, 0x11, 0x5b, 0xfd, 0xc0, 0xa4, 0xb6, 0x61,
-	0x99, 0x51, 0x31, 0xee, 0xb1, 0x98, 0x8e, 0x32, 0x86, 0x4a, 0xdb, 0x39, 0x2b, 0x6d, 0x7b, 0x41,
-	0xf0, 0x72, 0xec, 0xf7, 0xa4, 0x69, 0x7a, 0xec, 0xb4, 0x04, 0xf8, 0x41, 0x10, 0x4b, 0xcc, 0xdf,
-	0x15, 0x65, 0x25, 0x42, 0x79, 0x44, 0xf5, 0xe9, 0xfc, 0x18, 0xae, 0x29, 0xaa, 0x6c, 0x9c, 0xd3,
-	0xe1, 0x9d, 0x3d, 0x78, 0x4f, 0x31, 0x6f, 0x9e, 0xb2, 0xa2, 0xee, 0xa9, 0x04, 0xff, 0x5f, 0x75,
-	0x7a, 0x08, 0x2b, 0x5a, 0x27, 0xb3, 0x4e, 0x41, 0x9c, 0x69, 0x20, 0x63, 0x03, 0x71, 0xd8, 0x37,
-	0xa3, 0xf9, 0xe3, 0x81, 0xbe, 0xec, 0xd8, 0xb7, 0xf3, 0x4e, 0xa4, 0x7d, 0xac, 0x56, 0x70, 0xee,
-	0x0b, 0x63, 0x5d, 0x64, 0xba, 0xd8, 0x65, 0xca, 0x2d, 0x8c, 0xd3, 0x70, 0x8b, 0x04, 0x66, 0xd4,
-	0x98, 0x5b, 0x1c, 0x57, 0x68, 0xcc, 0xd9, 0x2d, 0x8d, 0x67, 0x2c, 0xff, 0x10, 0x8a, 0x13, 0x2a,
-	0xcf, 0x6b, 0x75, 0x9d, 0xac, 0x89, 0xd7, 0xe5, 0xb5, 0xa7, 0x48, 0xeb, 0x07, 0x2c, 0x6a, 0x5d,
-	0x3e, 0x6f, 0x2e, 0x16, 0xb7, 0xe2, 0x4b, 0xa1, 0x9b, 0x0a, 0xb5, 0x4c, 0xa9, 0x73, 0x5b, 0xc4,
-	0xa2, 0x8e, 0xd0, 0x4c, 0x60, 0xc7, 0xc2, 0x0b, 0x51, 0x60, 0x67, 0x3a, 0xd5, 0x58, 0x04, 0x86,
-	0x68, 0xb5, 0x3a, 0xd3, 0x62, 0xa0, 0x14, 0xd6, 0x51, 0x7f, 0x19, 0xd6, 0xeb, 0xe0, 0xcf, 0x04,
-	0xb6, 0x0b, 0xd7, 0xed, 0x33, 0x93, 0x09, 0xef, 0x10, 0x6e, 0xa5, 0x1d, 0xab, 0x4c, 0xb8, 0x4f,
-	0xa2, 0xd3, 0x71, 0x09, 0xd5, 0xbc, 0x69, 0xf6, 0xa5, 0x94, 0xdc, 0x72, 0x4f, 0xf4, 0x19, 0xbd,
-	0x2c, 0xb0, 0x4b, 0xdb, 0x60, 0xf3, 0xf4, 0x5f, 0xc6, 0x46, 0x18, 0x49, 0xe3, 0xb2, 0xd4, 0xbb,
-	0x8c, 0x8d, 0xf8, 0xbe, 0x03, 0x15, 0x5d, 0x3d, 0x18, 0x7f, 0x38, 0xa9, 0x42, 0x79, 0x77, 0x6f,
-	0xff, 0x69, 0x73, 0x13, 0xeb, 0x96, 0xf5, 0x7f, 0xe6, 0x21, 0xbf, 0x7d, 0x48, 0x36, 0x60, 0x5e,
-	0x3c, 0xf9, 0x5e, 0xf0, 0x28, 0xde, 0xb8, 0xe8, 0xf1, 0xd8, 0x99, 0x23, 0x9f, 0x43, 0x81, 0x3d,
-	0xfa, 0xa6, 0xbe, 0x8a, 0x37, 0xd2, 0x1f, 0x8e, 0x51, 0xba, 0x03, 0x55, 0xe3, 0x85, 0x97, 0xbc,
-	0xf1, 0x55, 0xbc, 0xf1, 0xe6, 0xd7, 0x63, 0xa1, 0x53, 0xe7, 0xd5, 0xc8, 0xd6, 0x29, 0x7a, 0x91,
-	0xb4, 0x75, 0x32, 0xde, 0xff, 0x50, 0x7a, 0x57, 0xbe, 0x2c, 0x77, 0x43, 0xf2, 0x7e, 0xc2, 0x43,
-	0xa5, 0xf9, 0x12, 0xd7, 0xb8, 0x9d, 0xce, 0xa0, 0xf0, 0xd6, 0xf7, 0x60, 0x9e, 0xbf, 0x52, 0x90,
-	0x2f, 0xd4, 0x47, 0x23, 0xe1, 0x0d, 0x27, 0xc5, 0xdd, 0xb1, 0xf7, 0x0d, 0x67, 0xee, 0x7e, 0xee,
-	0x87, 0xb9, 0xf5, 0x6f, 0xf3, 0x30, 0xcf, 0xbb, 0x56, 0xf2, 0x15, 0x40, 0xd4, 0xde, 0xdb, 0xda,
-	0xce, 0x3c, 0x18, 0xd8, 0xda, 0xce, 0xbe, 0x0c, 0x88, 0x1d, 0x31, 0xfa, 0x70, 0x92, 0x24, 0x12,
-	0xbb, 0xd6, 0xec, 0x1d, 0x49, 0x68, 0xe2, 0x11, 0xd5, 0x83, 0x7a, 0xbc, 0xcf, 0x26, 0x77, 0x12,
-	0xc4, 0xec, 0x76, 0xbd, 0x71, 0xf7, 0x62, 0xa6, 0x98, 0x57, 0xfe, 0x92, 0xc7, 0x7d, 0x13, 0x7f,
-	0xb7, 0xc5, 0x2d, 0xac, 0xe8, 0x56, 0x96, 0xdc, 0x4a, 0x6a, 0x73, 0xa2, 0x3a, 0xa2, 0xf1, 0x7e,
-	0xea, 0xbc, 0x56, 0xff, 0x19, 0xd4, 0xcc, 0xd6, 0x93, 0x7c, 0x90, 0xd8, 0x39, 0x99, 0xdd, 0x6b,
-	0xc3, 0xb9, 0x88, 0x65, 0x16, 0x58, 0xb4, 0x90, 0xc9, 0xc0, 0xb1, 0x0e, 0x35, 0x19, 0x38, 0xde,
-	0x81, 0x22, 0x30, 0x46, 0x46, 0xd4, 0x38, 0x92, 0x44, 0x13, 0x8d, 0x3e, 0xd3, 0x8e, 0x8c, 0xd9,
-	0x9e, 0x13, 0xe3, 0xf8, 0x3f, 0x79, 0xa8, 0x3e, 0xf1, 0xfa, 0xa3, 0x90, 0x8e, 0xd8, 0x43, 0x17,
-	0xcb, 0x1e, 0x3c, 0xd1, 0xd8, 0xe1, 0x6c, 0xb6, 0x69, 0x76, 0x38, 0xc7, 0x7a, 0x18, 0x54, 0xb3,
-	0x0d, 0x25, 0xd1, 0x4a, 0x10, 0x8b, 0x31, 0xd6, 0x72, 0x34, 0xde, 0x4d, 0x9e, 0x34, 0xad, 0x8d,
-	0xba, 0x52, 0xdb, 0xda, 0x99, 0x26, 0xb6, 0x71, 0x3b, 0x9d, 0x41, 0x43, 0xfe, 0x0c, 0x8a, 0xec,
-	0x41, 0x9b, 0x58, 0xa9, 0xc2, 0x78, 0xf3, 0x6e, 0x34, 0x92, 0xa6, 0x34, 0xc0, 0x13, 0x58, 0x50,
-	0x6f, 0xd4, 0xe4, 0x3d, 0x4b, 0xff, 0xf8, 0x7b, 0x76, 0xe3, 0x56, 0xda, 0xb4, 0x02, 0xc3, 0xf0,
-	0xfe, 0x6b, 0x05, 0x8a, 0xec, 0x9e, 0x60, 0xb6, 0x46, 0x65, 0xa4, 0x6d, 0xeb, 0x4c, 0x2f, 0x63,
-	0xdb, 0x3a, 0x5b, 0x81, 0x8a, 0x33, 0x6f, 0x54, 0x93, 0x24, 0x41, 0x24, 0xde, 0x0a, 0xd9, 0x67,
-	0x3e, 0xa1, 0x14, 0x15, 0xb1, 0x6d, 0x96, 0x95, 0x24, 0x41, 0xc8, 0xea, 0xa5, 0xec, 0xd8, 0x4e,
-	0xaa, 0x4a, 0x11, 0xf8, 0x29, 0x94, 0x65, 0x1d, 0x99, 0xa4, 0x6a, 0xbc, 0xb1, 0x4a, 0x52, 0xd5,
-	0x2a, 0x42, 0x23, 0x44, 0xac, 0x35, 0xd2, 0x10, 0xa3, 0x6e, 0x22, 0x0d, 0xd1, 0x28, 0x54, 0x10,
-	0xf1, 0x6b, 0x80, 0xa8, 0xa2, 0xb4, 0x93, 0x5d, 0x62, 0x8f, 0x66, 0x27, 0xbb, 0xe4, 0xa2, 0x14,
-	0xa1, 0xbf, 0x01, 0x32, 0x5b, 0x5c, 0x92, 0x8f, 0x93, 0xa5, 0x13, 0x3b, 0xbb, 0xc6, 0x27, 0x6f,
-	0xc7, 0xac, 0x97, 0x3c, 0x84, 0x8a, 0xae, 0x3b, 0x89, 0x93, 0x62, 0xbf, 0x79, 0xd3, 0xdc, 0xb9,
-	0x90, 0xc7, 0xf6, 0x92, 0xbc, 0x6b, 0x52, 0x84, 0xe2, 0xd7, 0xcd, 0xdd, 0x8b, 0x99, 0xcc, 0x2d,
-	0x95, 0xb5, 0x68, 0xd2, 0x96, 0xc6, 0x5b, 0xc9, 0xa4, 0x2d, 0xb5, 0x0a, 0xd9, 0x08, 0x31, 0x25,
-	0x48, 0xe2, 0x2d, 0x67, 0x1a, 0xe2, 0x4c, 0x90, 0x44, 0x55, 0x69, 0x92, 0xf9, 0x33, 0x1d, 0x6b,
-	0x92, 0xf9, 0xb3, 0x85, 0xad, 0xd8, 0x31, 0x5d, 0xa0, 0x26, 0xed, 0x98, 0xdd, 0xf2, 0x36, 0xee,
-	0x5c, 0xc8, 0x63, 0xab, 0x9c, 0xbe, 0x63, 0x33, 0x7d, 0x6f, 0x9a, 0xca, 0xf6, 0x8e, 0x6d, 0xd4,
-	0xfe, 0xf0, 0xf7, 0x5b, 0xb9, 0x3f, 0xe3, 0xbf, 0xbf, 0xe1, 0xbf, 0xe3, 0x12, 0xff, 0x1f, 0x5b,
-	0x3f, 0xfa, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xff, 0xf4, 0xad, 0xd4, 0x1a, 0x26, 0x00, 0x00,
+	// 3006 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xbd, 0x1a, 0x5d, 0x73, 0x1b, 0x49,
+	0x31, 0xfa, 0xb0, 0x64, 0xb5, 0x64, 0x59, 0x19, 0x3b, 0x89, 0xa3, 0x5c, 0x72, 0xc9, 0x26, 0xb9,
+	0x0b, 0xe4, 0x90, 0xc1, 0x1c, 0x0f, 0x14, 0x57, 0x39, 0x64, 0x4b, 0x49, 0x8c, 0x3f, 0x6f, 0x2d,
+	0x3b, 0x1c, 0x45, 0x95, 0x58, 0x4b, 0x1b, 0x5b, 0x15, 0x7d, 0xdd, 0xee, 0xca, 0x67, 0xa7, 0x28,
+	0x1e, 0x28, 0xe0, 0x07, 0xc0, 0x1b, 0xc5, 0x2b, 0x45, 0xdd, 0x3f, 0xb9, 0x82, 0x2a, 0xe0, 0x17,
+	0x00, 0xc5, 0x13, 0xc5, 0x0b, 0xef, 0x3c, 0xd1, 0xf3, 0xb9, 0xb3, 0x5f, 0x76, 0x0e, 0xf9, 0xee,
+	0xc1, 0xf6, 0x4e, 0x4f, 0x77, 0x4f, 0x77, 0xcf, 0x74, 0x4f, 0x77, 0x8f, 0xa1, 0xe0, 0x8c, 0x3b,
+	0xb5, 0xb1, 0x33, 0xf2, 0x46, 0xa4, 0x64, 0x7b, 0x9d, 0xae, 0x6b, 0x3b, 0x27, 0xb6, 0x33, 0x3e,
+	0xac, 0x2e, 0x1e, 0x8d, 0x8e, 0x46, 0x6c, 0x62, 0x99, 0x7e, 0x71, 0x9c, 0xea, 0x4d, 0x8a, 0xb3,
+	0x3c, 0x38, 0xe9, 0x74, 0xd8, 0xaf, 0xf1, 0xe1, 0xf2, 0xab, 0x13, 0x31, 0x75, 0x8b, 0x4d, 0x59,
+	0x13, 0xef, 0x98, 0xfd, 0xc2, 0x29, 0xfa, 0x87, 0x4f, 0x1a, 0xbf, 0x4c, 0x41, 0xd9, 0xb4, 0xdd,
+	0xf1, 0x68, 0xe8, 0xda, 0xcf, 0x6d, 0xab, 0x6b, 0x3b, 0xe4, 0x36, 0x40, 0xa7, 0x3f, 0x71, 0x3d,
+	0xdb, 0x69, 0xf7, 0xba, 0x4b, 0xa9, 0xbb, 0xa9, 0x47, 0x59, 0xb3, 0x20, 0x20, 0xeb, 0x5d, 0x72,
+	0x0b, 0x0a, 0x03, 0x7b, 0x70, 0xc8, 0x67, 0xd3, 0x6c, 0x76, 0x96, 0x03, 0x70, 0xb2, 0x0a, 0xb3,
+	0x8e, 0x7d, 0xd2, 0x73, 0x7b, 0xa3, 0xe1, 0x52, 0x06, 0xe7, 0x32, 0xa6, 0x1a, 0x53, 0x42, 0xc7,
+	0x7a, 0xe9, 0xb5, 0x91, 0xcd, 0x60, 0x29, 0xcb, 0x09, 0x29, 0xa0, 0x85, 0x63, 0xe3, 0x17, 0x33,
+	0x50, 0x32, 0xad, 0xe1, 0x91, 0x6d, 0xda, 0x9f, 0x4c, 0x6c, 0xd7, 0x23, 0x15, 0xc8, 0xbc, 0xb2,
+	0xcf, 0xd8, 0xf2, 0x25, 0x93, 0x7e, 0x72, 0x7a, 0xc4, 0x68, 0xdb, 0x43, 0xbe, 0x70, 0x89, 0xd2,
+	0x23, 0xa0, 0x39, 0xec, 0x92, 0x45, 0x98, 0xe9, 0xf7, 0x06, 0x3d, 0x4f, 0xac, 0xca, 0x07, 0x01,
+	0x71, 0xb2, 0x21, 0x71, 0xd6, 0x00, 0xdc, 0x91, 0xe3, 0xb5, 0x47, 0x0e, 0x2a, 0xbd, 0x34, 0x83,
+	0xb3, 0xe5, 0x95, 0x07, 0x35, 0xdd, 0xd4, 0x35, 0x5d, 0xa0, 0xda, 0x1e, 0x22, 0xef, 0x50, 0x5c,
+	0xb3, 0xe0, 0xca, 0x4f, 0xf2, 0x14, 0x8a, 0x8c, 0x89, 0x67, 0x39, 0x47, 0xb6, 0xb7, 0x94, 0x63,
+	0x5c, 0x1e, 0x5e, 0xc0, 0xa5, 0xc5, 0x90, 0x4d, 0xb6, 0x3c, 0xff, 0x26, 0x06, 0x94, 0x10, 0xbf,
+	0x67, 0xf5, 0x7b, 0xaf, 0xad, 0xc3, 0xbe, 0xbd, 0x94, 0x47, 0x46, 0xb3, 0x66, 0x00, 0xc6, 0xf6,
+	0x65, 0x34, 0x19, 0xa2, 0xc4, 0xc3, 0xfe, 0xd9, 0xd2, 0x2c, 0xc3, 0x28, 0x30, 0xc8, 0x0e, 0x02,
+	0xa8, 0x79, 0xd0, 0x4a, 0x2e, 0x9f, 0x2d, 0xb0, 0xd9, 0x59, 0x0a, 0x60, 0x93, 0x35, 0x58, 0x18,
+	0xf4, 0x86, 0xed, 0x8e, 0x63, 0x5b, 0x9e, 0xdd, 0x56, 0x36, 0x01, 0x66, 0x93, 0xab, 0x38, 0xb5,
+	0xc6, 0x66, 0x4c, 0x69, 0x1c, 0x8a, 0x6f, 0x9d, 0x46, 0xf0, 0x8b, 0x02, 0xdf, 0x3a, 0x0d, 0xe1,
+	0x3f, 0x82, 0x0a, 0xe5, 0x3f, 0x18, 0x75, 0x7d, 0xe4, 0x12, 0x43, 0x2e, 0x23, 0x7c, 0x6b, 0xd4,
+	0x0d, 0x60, 0x22, 0xe7, 0x00, 0xe6, 0x9c, 0xc0, 0xb4, 0x4e, 0x35, 0x4c, 0xa3, 0x06, 0x05, 0x65,
+	0x73, 0x32, 0x0b, 0xd9, 0xed, 0x9d, 0xed, 0x66, 0xe5, 0x0a, 0x01, 0xc8, 0xd5, 0xf7, 0xd6, 0x9a,
+	0xdb, 0x8d, 0x4a, 0x8a, 0x14, 0x21, 0xdf, 0x68, 0xf2, 0x41, 0xda, 0x58, 0x05, 0xf0, 0xad, 0x4b,
+	0xf2, 0x90, 0xd9, 0x68, 0x7e, 0x8c, 0xf8, 0x88, 0x73, 0xd0, 0x34, 0xf7, 0xd6, 0x77, 0xb6, 0x91,
+	0x00, 0x89, 0xd7, 0xcc, 0x66, 0xbd, 0xd5, 0xac, 0xa4, 0x29, 0xc6, 0xd6, 0x4e, 0xa3, 0x92, 0x21,
+	0x05, 0x98, 0x39, 0xa8, 0x6f, 0xee, 0x37, 0x2b, 0x59, 0xe3, 0x37, 0x29, 0x98, 0x13, 0xfb, 0xc5,
+	0x7d, 0x82, 0xbc, 0x0f, 0xb9, 0x63, 0xe6, 0x17, 0xec, 0x28, 0x16, 0x57, 0xde, 0x0a, 0x6d, 0x6e,
+	0xc0, 0x77, 0x4c, 0x81, 0x8b, 0xfb, 0x99, 0x79, 0x75, 0xe2, 0xe2, 0x29, 0xcd, 0x20, 0x49, 0xa5,
+	0xc6, 0x5d, 0xb2, 0xb6, 0x61, 0x9f, 0x1d, 0x58, 0xfd, 0x89, 0x6d, 0xd2, 0x49, 0x42, 0x20, 0x3b,
+	0x18, 0x39, 0x36, 0x3b, 0xb1, 0xb3, 0x26, 0xfb, 0xa6, 0xc7, 0x98, 0xed, 0xa8, 0x38, 0xad, 0x7c,
+	0x60, 0x7c, 0x96, 0x02, 0xd8, 0x9d, 0x78, 0xc9, 0xae, 0x81, 0x64, 0x27, 0x94, 0xb1, 0x70, 0x0b,
+	0x3e, 0x60, 0x3e, 0x61, 0x5b, 0xae, 0xad, 0x7c, 0x82, 0x0e, 0xc8, 0x3d, 0x28, 0xf5, 0x8e, 0x86,
+	0xb8, 0x58, 0x9b, 0x93, 0x64, 0xd9, 0xf2, 0x45, 0x0e, 0x63, 0xe2, 0x69, 0x28, 0x9c, 0x7e, 0x46,
+	0x47, 0xd9, 0x64, 0x5c, 0x6e, 0x40, 0x7e, 0x8c, 0xfb, 0xd7, 0x7e, 0x75, 0xc2, 0x0e, 0xfd, 0xac,
+	0x99, 0xa3, 0xc3, 0x8d, 0x13, 0x63, 0x08, 0x45, 0x26, 0xea, 0x54, 0xe6, 0xfb, 0x9a, 0xcf, 0x3d,
+	0xcd, 0xc8, 0xa2, 0x26, 0x94, 0xeb, 0xfd, 0x18, 0x48, 0xc3, 0xee, 0xdb, 0x78, 0x16, 0xa7, 0x88,
+	0x1e, 0x9a, 0x36, 0x99, 0x80, 0x36, 0xbf, 0x4e, 0xc1, 0x42, 0x80, 0xfd, 0x54, 0x6a, 0x2d, 0x41,
+	0xbe, 0xcb, 0x98, 0x71, 0x09, 0x32, 0xa6, 0x1c, 0x92, 0xc7, 0x30, 0x2b, 0x04, 0x70, 0x51, 0x82,
+	0xf8, 0x43, 0x93, 0xe7, 0x32, 0xb9, 0xc6, 0x7f, 0x52, 0x18, 0x2b, 0xb9, 0xa2, 0xfb, 0x43, 0xea,
+	0x53, 0x75, 0x98, 0x73, 0xf8, 0xb8, 0xcd, 0x54, 0x12, 0x42, 0x55, 0x93, 0xe3, 0xd0, 0xf3, 0x2b,
+	0x66, 0x49, 0x90, 0x30, 0x30, 0xf9, 0x1e, 0x14, 0x25, 0x8b, 0xf1, 0xc4, 0x13, 0x56, 0x5f, 0x0a,
+	0x32, 0xf0, 0x8f, 0x20, 0x92, 0x83, 0x40, 0x47, 0x20, 0x69, 0xc1, 0xa2, 0x24, 0xe6, 0x0a, 0x09,
+	0x31, 0x32, 0x8c, 0xcb, 0xdd, 0x20, 0x97, 0xe8, 0x6e, 0x21, 0x37, 0x22, 0xe8, 0xb5, 0xc9, 0xd5,
+	0x02, 0xe4, 0x05, 0xd4, 0xf8, 0x2f, 0x75, 0x4b, 0x61, 0x53, 0xae, 0x72, 0x03, 0xca, 0x8e, 0x00,
+	0x04, 0x74, 0xbe, 0x15, 0xab, 0xb3, 0xd8, 0x8d, 0x2b, 0xe6, 0x9c, 0x24, 0xe2, 0x5a, 0x3f, 0x81,
+	0x92, 0xe2, 0xe2, 0xab, 0x7d, 0x33, 0x46, 0x6d, 0xc5, 0xa1, 0x28, 0x09, 0xa8, 0xe2, 0x2f, 0xe0,
+	0x9a, 0xa2, 0x8f, 0xd1, 0xfc, 0xde, 0x39, 0x9a, 0x2b, 0x86, 0x0b, 0x92, 0x83, 0xae, 0x3b, 0xd0,
+	0x8b, 0x8b, 0x83, 0x8d, 0xcf, 0x32, 0x90, 0x5f, 0x1b, 0x0d, 0xc6, 0x96, 0x43, 0xb7, 0x29, 0x87,
+	0xf0, 0x49, 0xdf, 0x63, 0xea, 0x96, 0x57, 0xee, 0x07, 0x57, 0x10, 0x68, 0xf2, 0xaf, 0xc9, 0x50,
+	0x4d, 0x41, 0x42, 0x89, 0xc5, 0x3d, 0x95, 0x7e, 0x03, 0x62, 0x71, 0x4b, 0x09, 0x12, 0xe9, 0x51,
+	0x19, 0xdf, 0xa3, 0xaa, 0x90, 0x47, 0x42, 0xff, 0x6e, 0x45, 0x5d, 0x24, 0x00, 0x1d, 0x78, 0x3e,
+	0x7c, 0x77, 0xcc, 0x08, 0x9c, 0x72, 0x27, 0x78, 0x75, 0xdc, 0x87, 0x52, 0xe0, 0x32, 0xc8, 0x09,
+	0xbc, 0xe2, 0x40, 0xbb, 0x35, 0xae, 0xcb, 0x00, 0x47, 0x2f, 0xc6, 0x12, 0xce, 0xf2, 0xa1, 0xf1,
+	0x7d, 0x98, 0x0b, 0xe8, 0x4a, 0x63, 0x79, 0xf3, 0xa3, 0xfd, 0xfa, 0x26, 0x0f, 0xfc, 0xcf, 0x58,
+	0xac, 0x37, 0x31, 0xf0, 0xe3, 0xfd, 0xb1, 0xd9, 0xdc, 0xdb, 0xc3, 0xb0, 0x3f, 0x07, 0x85, 0xed,
+	0x9d, 0x56, 0x9b, 0x63, 0x65, 0x8c, 0x0f, 0x14, 0x07, 0x71, 0x71, 0x68, 0xf7, 0xc5, 0x15, 0xed,
+	0xbe, 0x48, 0xc9, 0xfb, 0x22, 0xed, 0xdf, 0x17, 0x99, 0xd5, 0x32, 0x94, 0xb8, 0x7d, 0xda, 0x13,
+	0x7a, 0x2c, 0x59, 0xa4, 0x6e, 0x9d, 0x0e, 0x65, 0x18, 0x5a, 0x86, 0x7c, 0x87, 0x33, 0xc7, 0xfd,
+	0xa2, 0x5e, 0x7d, 0x2d, 0xd6, 0xe4, 0xa6, 0xc4, 0xc2, 0xb8, 0x92, 0x77, 0x27, 0x9d, 0x8e, 0xed,
+	0xca, 0xbb, 0x23, 0xec, 0xc3, 0x9a, 0xdb, 0x9b, 0x12, 0x95, 0x52, 0xbd, 0xb4, 0x7a, 0xfd, 0x09,
+	0xbb, 0x4c, 0x2e, 0xa4, 0x12, 0xa8, 0xc6, 0xef, 0x52, 0x50, 0x64, 0xb2, 0x4e, 0x15, 0xd3, 0xde,
+	0x82, 0x02, 0x13, 0xc3, 0xee, 0x8a, 0xa8, 0x86, 0x49, 0x89, 0x02, 0x90, 0xef, 0x62, 0xd4, 0x15,
+	0x74, 0x32, 0xb0, 0xdd, 0x8a, 0x67, 0xcb, 0x85, 0xf3, 0xb1, 0x8d, 0x0d, 0xb8, 0xca, 0xcc, 0xd3,
+	0xf1, 0xe8, 0x84, 0x30, 0xa8, 0x9e, 0xd0, 0xa5, 0x42, 0x09, 0x1d, 0xce, 0x8d, 0x8f, 0xcf, 0xdc,
+	0x5e, 0xc7, 0xea, 0x0b, 0x41, 0xd4, 0xd8, 0xf8, 0x01, 0x10, 0x9d, 0xd9, 0x34, 0x1a, 0x1b, 0x73,
+	0x50, 0x7c, 0x6e, 0xb9, 0xc7, 0x42, 0x24, 0xe3, 0x87, 0x50, 0xe2, 0xc3, 0xa9, 0xcc, 0x88, 0xc9,
+	0xc0, 0x31, 0x72, 0x61, 0x82, 0xcf, 0x99, 0xec, 0xdb, 0xb8, 0x0a, 0xf3, 0x7b, 0x43, 0x6b, 0xec,
+	0x1e, 0x8f, 0x64, 0xdc, 0xa5, 0xe9, 0x7a, 0xc5, 0x87, 0x4d, 0xb5, 0xe2, 0xbb, 0x30, 0xef, 0xd8,
+	0x03, 0xab, 0x37, 0xec, 0x0d, 0x8f, 0xda, 0x87, 0x67, 0x9e, 0xed, 0x8a, 0x6c, 0xbe, 0xac, 0xc0,
+	0xab, 0x14, 0x4a, 0x45, 0x3b, 0xec, 0x8f, 0x0e, 0x85, 0xeb, 0xb3, 0x6f, 0xe3, 0x57, 0x69, 0x28,
+	0xbd, 0xb0, 0xbc, 0x8e, 0xb4, 0x02, 0x59, 0x87, 0xb2, 0x72, 0x78, 0x06, 0x11, 0xb2, 0x84, 0x82,
+	0x3f, 0xa3, 0x91, 0xb9, 0xa3, 0x0c, 0xfe, 0x73, 0x1d, 0x1d, 0xc0, 0x58, 0x59, 0xc3, 0x8e, 0xdd,
+	0x57, 0xac, 0xd2, 0xc9, 0xac, 0x18, 0xa2, 0xce, 0x4a, 0x07, 0x90, 0x1d, 0xa8, 0x60, 0x99, 0x73,
+	0x84, 0x87, 0xca, 0x55, 0xcc, 0x78, 0x68, 0x36, 0x62, 0x98, 0xed, 0x0a, 0x54, 0x9f, 0xdd, 0xfc,
+	0x38, 0x08, 0x5a, 0x9d, 0xf7, 0x6f, 0x5a, 0xee, 0xf0, 0xbf, 0x4d, 0x03, 0x89, 0x2a, 0xf5, 0x45,
+	0xf3, 0x8f, 0x87, 0x50, 0x76, 0x31, 0x8e, 0x78, 0xed, 0x50, 0xf1, 0x34, 0xc7, 0xa0, 0x2a, 0x0a,
+	0xe2, 0x96, 0x29, 0x75, 0x86, 0x23, 0xaf, 0xf7, 0xf2, 0x4c, 0x64, 0x6f, 0x65, 0x09, 0xde, 0x66,
+	0x50, 0xd2, 0xc4, 0x80, 0xd0, 0xeb, 0x63, 0xa1, 0xe5, 0x62, 0xd8, 0xcd, 0x60, 0xa8, 0x7f, 0x7c,
+	0xd1, 0x36, 0xd4, 0x9e, 0x32, 0xfc, 0xd6, 0xd9, 0x18, 0xa3, 0x91, 0xa0, 0x4d, 0x4e, 0xf2, 0x1e,
+	0x02, 0xf8, 0xf8, 0x34, 0x1e, 0x6e, 0xef, 0xec, 0xee, 0xb7, 0x30, 0x5e, 0x96, 0x60, 0x76, 0x7b,
+	0xa7, 0xd1, 0xdc, 0x6c, 0xd2, 0x88, 0x69, 0x2c, 0x4b, 0xdb, 0x04, 0x36, 0xe5, 0x26, 0xcc, 0x7e,
+	0x4a, 0xa1, 0xb2, 0xba, 0xc4, 0x34, 0x88, 0x8d, 0xd7, 0xbb, 0xc6, 0x75, 0x58, 0x8c, 0xdb, 0x09,
+	0xe3, 0x5f, 0x78, 0xff, 0x8b, 0xe3, 0x36, 0xd5, 0x99, 0xd7, 0x97, 0x4e, 0x07, 0x96, 0xa6, 0xb9,
+	0x19, 0x3f, 0x86, 0x5d, 0x91, 0x02, 0xca, 0x21, 0x8d, 0x2b, 0xfc, 0x54, 0xe1, 0x14, 0x37, 0xb7,
+	0x1a, 0xe3, 0x3d, 0x57, 0xe9, 0xf0, 0xb8, 0x12, 0xba, 0xe8, 0xcc, 0x79, 0x01, 0x57, 0x9b, 0xf7,
+	0x10, 0x72, 0xf6, 0x89, 0x3d, 0xf4, 0x5c, 0xac, 0xa2, 0x68, 0x1c, 0x9c, 0x93, 0x09, 0x5e, 0x93,
+	0x42, 0x4d, 0x31, 0x69, 0x7c, 0x07, 0xae, 0xb2, 0x0c, 0xfb, 0x19, 0x1e, 0x0e, 0x3d, 0xe3, 0x6f,
+	0xb5, 0x36, 0x85, 0xb5, 0x32, 0x5e, 0x6b, 0x93, 0x94, 0x21, 0xbd, 0xde, 0x10, 0x3a, 0xa4, 0x7b,
+	0x0d, 0xe3, 0xe7, 0x29, 0x20, 0x3a, 0xdd, 0x54, 0x66, 0x0a, 0x31, 0x97, 0xcb, 0x67, 0xfc, 0xe5,
+	0xb1, 0xb4, 0xb0, 0x1d, 0x67, 0xe4, 0x30, 0x83, 0x14, 0x4c, 0x3e, 0x30, 0x1e, 0x08, 0x19, 0x50,
+	0xe7, 0xd1, 0x2b, 0xe5, 0x0b, 0x9c, 0x5b, 0x4a, 0x89, 0xba, 0x01, 0x0b, 0x01, 0xac, 0xa9, 0x82,
+	0xf1, 0xbb, 0x70, 0x8d, 0x31, 0xdb, 0xb0, 0xed, 0x71, 0xbd, 0xdf, 0x3b, 0x49, 0x5c, 0x75, 0x0c,
+	0xd7, 0xc3, 0x88, 0x5f, 0xae, 0x8d, 0x30, 0xb3, 0xe0, 0x2b, 0xb6, 0x7a, 0x03, 0xbb, 0x35, 0xda,
+	0x4c, 0x96, 0x8d, 0x46, 0x58, 0x5a, 0xa9, 0x8b, 0x5b, 0x8b, 0x7d, 0x1b, 0xbf, 0x4f, 0xc1, 0x8d,
+	0x08, 0xf9, 0x97, 0xbc, 0xab, 0x77, 0x00, 0x8e, 0xe8, 0xf1, 0xb1, 0xbb, 0x74, 0x82, 0x97, 0xa0,
+	0x1a, 0x44, 0xc9, 0x49, 0x63, 0x4a, 0x49, 0xc8, 0xb9, 0x28, 0xf6, 0x9c, 0xfd, 0x52, 0x0e, 0x7b,
+	0x1b, 0x8a, 0x0c, 0xb0, 0xe7, 0x59, 0xde, 0xc4, 0x8d, 0x6c, 0xc6, 0xcf, 0xc4, 0x11, 0x90, 0x44,
+	0x53, 0xe9, 0xf5, 0x2d, 0xc8, 0xb1, 0x32, 0x55, 0xa6, 0x4c, 0xa1, 0xf4, 0x5d, 0x93, 0xc3, 0x14,
+	0x88, 0xc6, 0x31, 0xe4, 0xb6, 0x58, 0xcb, 0x4a, 0x93, 0x2c, 0x2b, 0xb7, 0x62, 0x68, 0x0d, 0x78,
+	0x21, 0x5d, 0x30, 0xd9, 0x37, 0x4b, 0x2c, 0x6c, 0xdb, 0xd9, 0x37, 0x37, 0x79, 0x0e, 0x53, 0x30,
+	0xd5, 0x98, 0x9a, 0xac, 0xd3, 0xef, 0xa1, 0xe7, 0xb2, 0xd9, 0x2c, 0x9b, 0xd5, 0x20, 0x46, 0x0d,
+	0x2a, 0x7c, 0xa5, 0x7a, 0xb7, 0xab, 0x25, 0x31, 0x8a, 0x5f, 0x2a, 0xc8, 0xcf, 0xf8, 0x43, 0x0a,
+	0xae, 0x6a, 0x04, 0x53, 0x19, 0xe6, 0x3d, 0xc8, 0xf1, 0xc6, 0x9c, 0xb8, 0x40, 0x17, 0x83, 0x54,
+	0x7c, 0x19, 0x53, 0xe0, 0x90, 0x1a, 0xe4, 0xf9, 0x97, 0x4c, 0xd4, 0xe2, 0xd1, 0x25, 0x12, 0xde,
+	0x01, 0x0b, 0x02, 0x64, 0x0f, 0x46, 0x71, 0x67, 0x9b, 0x19, 0xd4, 0xf8, 0x29, 0x2c, 0x06, 0xd1,
+	0xa6, 0x52, 0x49, 0x13, 0x32, 0xfd, 0x26, 0x42, 0xd6, 0xa5, 0x90, 0xfb, 0xe3, 0xae, 0x76, 0x3d,
+	0x87, 0x77, 0x5d, 0xdf, 0x91, 0x74, 0x68, 0x47, 0x94, 0x02, 0x92, 0xc5, 0x57, 0xaa, 0xc0, 0x82,
+	0x3c, 0x0e, 0x9b, 0x3d, 0x57, 0x65, 0x81, 0xaf, 0x81, 0xe8, 0xc0, 0xaf, 0x5a, 0xa0, 0x86, 0xfd,
+	0xd2, 0xb1, 0x8e, 0x06, 0xb6, 0xba, 0x9f, 0x68, 0x7a, 0xad, 0x03, 0xa7, 0x8a, 0xe8, 0xcb, 0xa8,
+	0x31, 0x1e, 0x94, 0x4d, 0x0e, 0xf5, 0x5d, 0x86, 0xd7, 0x59, 0x6a, 0xdb, 0xd4, 0x98, 0x2e, 0xae,
+	0x13, 0x4c, 0xb5, 0xf8, 0x5f, 0x52, 0x50, 0xaa, 0xf7, 0x2d, 0x67, 0x20, 0x17, 0x7e, 0x02, 0x39,
+	0x5e, 0x34, 0x88, 0x82, 0xfb, 0x9d, 0x20, 0x1b, 0x1d, 0x97, 0x0f, 0xea, 0xbc, 0xc4, 0x10, 0x54,
+	0x54, 0x70, 0xd1, 0x1c, 0x6f, 0x84, 0x9a, 0xe5, 0x0d, 0xf2, 0x0d, 0x98, 0xb1, 0x28, 0x09, 0x0b,
+	0xc1, 0xe5, 0x95, 0x1b, 0x31, 0xac, 0x59, 0x3e, 0xc6, 0xb1, 0x8c, 0xf7, 0xa1, 0xa8, 0xad, 0x40,
+	0xcb, 0xd1, 0x67, 0x4d, 0x91, 0x73, 0xd5, 0xd7, 0x5a, 0xeb, 0x07, 0xbc, 0x4a, 0x2d, 0x03, 0x34,
+	0x9a, 0x6a, 0x9c, 0xc6, 0xf2, 0x84, 0x53, 0x89, 0x78, 0xa7, 0xcb, 0x93, 0x4a, 0x92, 0x27, 0xfd,
+	0x46, 0xf2, 0x9c, 0xc2, 0x9c, 0x50, 0x7f, 0xda, 0xf0, 0xcd, 0xf8, 0x25, 0x84, 0x6f, 0x4d, 0x78,
+	0x53, 0x20, 0x1a, 0x98, 0x85, 0x8b, 0x80, 0x2e, 0xce, 0xdf, 0x1f, 0x53, 0x50, 0x96, 0x90, 0x69,
+	0x3b, 0x74, 0xb2, 0xa7, 0xc1, 0x6f, 0x00, 0xd5, 0xd1, 0xb8, 0x0e, 0xb9, 0xee, 0xe1, 0x5e, 0xef,
+	0xb5, 0xec, 0xa6, 0x8a, 0x11, 0x85, 0xf7, 0xf9, 0x3a, 0xfc, 0x49, 0x43, 0x8c, 0x68, 0x5d, 0x4c,
+	0x1f, 0x37, 0xd6, 0x87, 0x5d, 0xfb, 0x94, 0xa5, 0x84, 0x59, 0xd3, 0x07, 0xb0, 0x3a, 0x56, 0x3c,
+	0x7d, 0xb0, 0xd4, 0x5a, 0x7f, 0x0a, 0x41, 0x0f, 0xab, 0x4f, 0xbc, 0xe3, 0xe6, 0x90, 0x76, 0xfd,
+	0xa5, 0x86, 0x78, 0xcd, 0x52, 0x60, 0xa3, 0xe7, 0xea, 0xd0, 0x26, 0x2c, 0x50, 0x28, 0x3a, 0x1d,
+	0x56, 0xb9, 0x7e, 0x78, 0x93, 0x97, 0x58, 0x2a, 0x74, 0x89, 0x59, 0xae, 0xfb, 0xe9, 0xc8, 0xe9,
+	0x0a, 0xd5, 0xd4, 0xd8, 0x68, 0x70, 0xe6, 0xfb, 0x6e, 0xe0, 0x9a, 0xfa, 0xa2, 0x5c, 0x16, 0x7d,
+	0x2e, 0xcf, 0x6c, 0x15, 0x1a, 0x1e, 0xc3, 0x35, 0x09, 0x15, 0x0d, 0xae, 0x64, 0xf6, 0xc6, 0x0e,
+	0xdc, 0x96, 0xc8, 0x6b, 0xc7, 0xb4, 0x38, 0xda, 0x15, 0xcc, 0xff, 0x5f, 0x99, 0x9e, 0xc0, 0xa2,
+	0x92, 0x49, 0x4f, 0xa8, 0x91, 0xcf, 0xc4, 0x15, 0x67, 0x03, 0xf9, 0xd0, 0x6f, 0x0a, 0x73, 0x46,
+	0x7d, 0x75, 0xf5, 0xd3, 0x6f, 0xe3, 0x43, 0x5f, 0xfa, 0x60, 0x52, 0xfb, 0xa6, 0x0c, 0x1e, 0x71,
+	0xa3, 0x98, 0xf8, 0x7d, 0xbe, 0x69, 0xa5, 0xf9, 0x28, 0x66, 0xd4, 0x7c, 0x14, 0x7a, 0xb1, 0xf9,
+	0x4c, 0xae, 0x2d, 0x63, 0x11, 0xd2, 0x36, 0x62, 0xb5, 0x77, 0x20, 0x3b, 0xb6, 0x85, 0xaf, 0x17,
+	0x57, 0x48, 0x8d, 0x3f, 0x0d, 0xd6, 0x76, 0x11, 0xd6, 0x73, 0xe9, 0x89, 0x37, 0xd9, 0xbc, 0xf1,
+	0x23, 0x5f, 0x80, 0x88, 0x05, 0x22, 0x4c, 0x45, 0xd9, 0xcb, 0x0d, 0x10, 0x2d, 0x7b, 0x33, 0x7c,
+	0x77, 0x64, 0xd9, 0x4b, 0x23, 0xb7, 0x7e, 0xd2, 0xa7, 0x8a, 0xdc, 0x1b, 0xdc, 0x15, 0x94, 0x83,
+	0x4c, 0xc5, 0xec, 0x90, 0x1b, 0xd2, 0xf7, 0xab, 0xa9, 0x82, 0x0a, 0x16, 0x4b, 0x1e, 0x1a, 0x4e,
+	0x86, 0x14, 0x3e, 0x90, 0x02, 0x2b, 0xa7, 0xbb, 0x0c, 0xed, 0x95, 0xef, 0x4d, 0xc5, 0x6c, 0x1b,
+	0xae, 0x87, 0x5d, 0x76, 0x2a, 0x7e, 0x07, 0x70, 0x27, 0xc9, 0xab, 0xa7, 0xe2, 0xbb, 0xe5, 0x3b,
+	0xe7, 0x25, 0x54, 0xbd, 0xba, 0xda, 0x97, 0x52, 0x9a, 0x8a, 0x3d, 0x51, 0xae, 0x7f, 0x59, 0xcc,
+	0x2e, 0x6d, 0x83, 0xf5, 0xa0, 0x72, 0x19, 0x1b, 0xa1, 0xc5, 0x9d, 0xcb, 0x12, 0xef, 0x32, 0x36,
+	0xe2, 0xeb, 0x06, 0x14, 0x54, 0xf2, 0xa2, 0x3d, 0x24, 0x17, 0x21, 0xbf, 0xbd, 0xb3, 0xb7, 0x5b,
+	0x5f, 0xc3, 0xb4, 0x69, 0xe5, 0xdf, 0x69, 0x48, 0x6f, 0x1c, 0x90, 0x55, 0x98, 0xe1, 0x2f, 0x43,
+	0xe7, 0xbc, 0x9d, 0x55, 0xcf, 0x7b, 0x63, 0x32, 0xae, 0x90, 0x0f, 0x20, 0x43, 0xdf, 0x86, 0x12,
+	0x1f, 0xcf, 0xaa, 0xc9, 0xef, 0x4b, 0x48, 0xdd, 0x82, 0xa2, 0xf6, 0x10, 0x44, 0x2e, 0x7c, 0x3c,
+	0xab, 0x5e, 0xfc, 0xc8, 0xc4, 0x65, 0x6a, 0x9d, 0x0e, 0xc3, 0x32, 0xf9, 0x2f, 0x15, 0x61, 0x99,
+	0xb4, 0x77, 0x01, 0xa4, 0xde, 0x16, 0x0f, 0x50, 0x1d, 0x8f, 0xbc, 0x1d, 0xf3, 0x80, 0xa1, 0x77,
+	0xe8, 0xab, 0x77, 0x93, 0x11, 0x24, 0xbf, 0x95, 0x1d, 0x98, 0x61, 0xdd, 0x3c, 0xf2, 0x54, 0x7e,
+	0x54, 0x63, 0xfa, 0x93, 0x09, 0xe6, 0x0e, 0xf4, 0x01, 0x8d, 0x2b, 0x8f, 0x52, 0xdf, 0x4c, 0xad,
+	0xfc, 0x29, 0x03, 0x33, 0xfc, 0x5d, 0xfa, 0x23, 0x00, 0xbf, 0x0d, 0x16, 0x96, 0x36, 0xd2, 0x58,
+	0x0b, 0x4b, 0x1b, 0xed, 0xa0, 0xf1, 0x1d, 0xd1, 0xfa, 0x55, 0x24, 0x8e, 0x24, 0x70, 0x33, 0x86,
+	0x77, 0x24, 0xa6, 0xd9, 0x85, 0x5c, 0x2d, 0x28, 0x07, 0xfb, 0x51, 0xe4, 0x7e, 0x0c, 0x59, 0xb8,
+	0xad, 0x55, 0x7d, 0x70, 0x3e, 0x92, 0x6e, 0x15, 0xf2, 0x13, 0x98, 0x0f, 0x75, 0x90, 0x48, 0x1c,
+	0x79, 0xa4, 0x3f, 0x55, 0x7d, 0x78, 0x01, 0x56, 0xc4, 0x34, 0xbc, 0x8f, 0x13, 0x6b, 0x9a, 0x40,
+	0x5f, 0x28, 0xd6, 0x34, 0xc1, 0x26, 0x10, 0x1e, 0x8f, 0xbf, 0xa5, 0xf1, 0xbc, 0xf1, 0xff, 0x37,
+	0xc2, 0xa3, 0x57, 0x50, 0xed, 0x10, 0x72, 0x27, 0xae, 0x34, 0xf5, 0xd3, 0xaa, 0xea, 0xdb, 0x89,
+	0xf3, 0x4a, 0xe2, 0x17, 0x50, 0xd2, 0xdb, 0x11, 0xe4, 0x5e, 0x6c, 0xb5, 0xab, 0x77, 0x34, 0xaa,
+	0xc6, 0x79, 0x28, 0x51, 0xc6, 0xbc, 0x4d, 0x10, 0xcf, 0x38, 0xd0, 0x85, 0x88, 0x67, 0x1c, 0xec,
+	0x32, 0x20, 0x63, 0x3c, 0xd1, 0x7e, 0xb1, 0x4f, 0x62, 0x55, 0xd4, 0x7a, 0x03, 0xe1, 0x13, 0x1d,
+	0xed, 0x13, 0xa0, 0x81, 0xff, 0x9c, 0x81, 0xe2, 0x96, 0xd5, 0x1b, 0x7a, 0xf6, 0x90, 0x36, 0xb2,
+	0x69, 0xd4, 0x63, 0x01, 0x32, 0xec, 0x86, 0x7a, 0x75, 0x1b, 0x76, 0xc3, 0x40, 0xe9, 0x87, 0x62,
+	0x36, 0x21, 0x27, 0x9a, 0x7d, 0x21, 0xc4, 0x40, 0xa5, 0x56, 0x7d, 0x2b, 0x7e, 0x52, 0xd7, 0xd6,
+	0xef, 0x24, 0x84, 0xb5, 0x8d, 0x34, 0x1e, 0xaa, 0x77, 0x93, 0x11, 0x14, 0xcb, 0x0f, 0x21, 0x4b,
+	0x1f, 0xe8, 0x48, 0x28, 0xc4, 0x69, 0x6f, 0x78, 0xd5, 0x6a, 0xdc, 0x94, 0x62, 0xb0, 0x05, 0xb3,
+	0xf2, 0xcd, 0x8d, 0xdc, 0x0e, 0xc9, 0x1f, 0x7c, 0x9f, 0xab, 0xde, 0x49, 0x9a, 0x96, 0xcc, 0xd0,
+	0x2d, 0xe9, 0x86, 0xaa, 0x7e, 0x45, 0x64, 0x43, 0xc3, 0xad, 0x8f, 0xc8, 0x86, 0x46, 0x5a, 0x1d,
+	0xb8, 0xa1, 0x7f, 0x2f, 0x40, 0x96, 0x5e, 0x99, 0x94, 0xb7, 0x9f, 0x51, 0x87, 0x79, 0x47, 0xaa,
+	0xca, 0x30, 0xef, 0x68, 0x32, 0xce, 0x7d, 0x5c, 0x4b, 0xac, 0x49, 0x0c, 0x49, 0xb0, 0x28, 0x0d,
+	0xfb, 0x78, 0x4c, 0x56, 0xce, 0xdd, 0x45, 0xcf, 0xb0, 0x49, 0x0c, 0x51, 0xa8, 0xaa, 0x0d, 0xbb,
+	0x4b, 0x5c, 0x82, 0x8e, 0x8c, 0x77, 0x21, 0x2f, 0x52, 0xea, 0x38, 0x51, 0x83, 0x25, 0x6e, 0x9c,
+	0xa8, 0xa1, 0x7c, 0xdc, 0xe7, 0x88, 0x69, 0x57, 0x12, 0x47, 0xbf, 0x5e, 0x4b, 0xe2, 0xa8, 0xe5,
+	0x6c, 0xc8, 0xf1, 0x63, 0x00, 0x3f, 0xb9, 0x0e, 0xc7, 0xfd, 0xd8, 0x6a, 0x39, 0x1c, 0xf7, 0xe3,
+	0xf3, 0x73, 0x64, 0xfd, 0x09, 0x90, 0x68, 0x9e, 0x4d, 0x1e, 0xc7, 0x53, 0xc7, 0xd6, 0xd8, 0xd5,
+	0xf7, 0xde, 0x0c, 0x59, 0x2d, 0x79, 0x00, 0x05, 0x95, 0x82, 0x13, 0x23, 0x41, 0x7f, 0xfd, 0xd2,
+	0xbd, 0x7f, 0x2e, 0x4e, 0xd8, 0x4a, 0xe2, 0xda, 0x4d, 0x20, 0x0a, 0xde, 0xbc, 0x0f, 0xce, 0x47,
+	0xd2, 0xb7, 0x54, 0xa4, 0xe5, 0x71, 0x5b, 0x1a, 0x2c, 0xd6, 0xe3, 0xb6, 0x34, 0x94, 0xd3, 0xfb,
+	0x1c, 0x13, 0x0e, 0x49, 0xb0, 0xa8, 0x4f, 0xe2, 0x18, 0x39, 0x24, 0x7e, 0x82, 0x1e, 0xa7, 0x7e,
+	0xa4, 0x27, 0x10, 0xa7, 0x7e, 0x34, 0xc7, 0xe7, 0x3b, 0xa6, 0x72, 0xf5, 0xb8, 0x1d, 0x0b, 0x37,
+	0x10, 0xaa, 0xf7, 0xcf, 0xc5, 0x09, 0x8b, 0x9c, 0xbc, 0x63, 0x91, 0x2e, 0x42, 0x92, 0xc8, 0xe1,
+	0x1d, 0x5b, 0x2d, 0x7d, 0xfe, 0xcf, 0x3b, 0xa9, 0xbf, 0xe2, 0xcf, 0x3f, 0xf0, 0xe7, 0x30, 0xc7,
+	0xfe, 0x79, 0xf9, 0xdb, 0xff, 0x03, 0xc8, 0xaf, 0x03, 0x0d, 0x25, 0x2d, 0x00, 0x00,
 }
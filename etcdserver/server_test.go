@@ -1373,6 +1373,8 @@ func (n *nodeRecorder) ReportUnreachable(id uint64) {}
 
 func (n *nodeRecorder) ReportSnapshot(id uint64, status raft.SnapshotStatus) {}
 
+func (n *nodeRecorder) TransferLeadership(ctx context.Context, lead, transferee uint64) {}
+
 func (n *nodeRecorder) Compact(index uint64, nodes []uint64, d []byte) {
 	n.Record(testutil.Action{Name: "Compact"})
 }
@@ -174,3 +174,68 @@ func TestElectionSessionRecampaign(t *testing.T) {
 		t.Fatalf("expected value=%q, got response %v", "def", resp)
 	}
 }
+
+// TestElectionPrefix ensures elections whose names share a prefix are
+// independent.
+func TestElectionPrefix(t *testing.T) {
+	clus := NewClusterV3(t, &ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+	cli := clus.RandClient()
+
+	e := concurrency.NewElection(cli, "test-elect-ab")
+	if err := e.Campaign(context.TODO(), "abc"); err != nil {
+		t.Fatal(err)
+	}
+
+	e2 := concurrency.NewElection(cli, "test-elect-a")
+	if _, err := e2.Leader(context.TODO()); err != concurrency.ErrElectionNoLeader {
+		t.Fatalf("expected %v, got %v", concurrency.ErrElectionNoLeader, err)
+	}
+	ctx, cancel := context.WithTimeout(context.TODO(), 5*time.Second)
+	defer cancel()
+	if err := e2.Campaign(ctx, "def"); err != nil {
+		t.Fatal(err)
+	}
+	if l, err := e2.Leader(context.TODO()); err != nil || l != "def" {
+		t.Fatalf("expected leader %q, got %q (%v)", "def", l, err)
+	}
+}
+
+// TestElectionCampaignCancel ensures a candidate that stops waiting leaves
+// the election.
+func TestElectionCampaignCancel(t *testing.T) {
+	clus := NewClusterV3(t, &ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+	cli := clus.Client(0)
+
+	e := concurrency.NewElection(cli, "test-elect")
+	if err := e.Campaign(context.TODO(), "abc"); err != nil {
+		t.Fatal(err)
+	}
+
+	// a separate client gets a separate session
+	cli2, err := NewClientV3(clus.Members[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cli2.Close()
+
+	e2 := concurrency.NewElection(cli2, "test-elect")
+	ctx, cancel := context.WithTimeout(context.TODO(), 500*time.Millisecond)
+	err = e2.Campaign(ctx, "def")
+	cancel()
+	if err != context.DeadlineExceeded {
+		t.Fatalf("expected %v, got %v", context.DeadlineExceeded, err)
+	}
+	if e2.Key() != "" {
+		t.Fatalf("expected no key after cancel, got %q", e2.Key())
+	}
+
+	resp, err := cli.Get(context.TODO(), "test-elect/", clientv3.WithPrefix())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Kvs) != 1 || string(resp.Kvs[0].Key) != e.Key() {
+		t.Fatalf("expected only %q to remain, got %+v", e.Key(), resp.Kvs)
+	}
+}
@@ -92,6 +92,42 @@ func TestMutexSessionRelock(t *testing.T) {
 	}
 }
 
+// TestMutexWaitCancel checks that a waiter that gives up removes its lock
+// key so it does not block later waiters.
+func TestMutexWaitCancel(t *testing.T) {
+	clus := NewClusterV3(t, &ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	cli := clus.Client(0)
+	m1 := concurrency.NewMutex(cli, "test-mutex")
+	if err := m1.Lock(context.TODO()); err != nil {
+		t.Fatal(err)
+	}
+
+	// a separate client gets a separate session
+	cli2, err := NewClientV3(clus.Members[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cli2.Close()
+
+	m2 := concurrency.NewMutex(cli2, "test-mutex")
+	ctx, cancel := context.WithTimeout(context.TODO(), 500*time.Millisecond)
+	err = m2.Lock(ctx)
+	cancel()
+	if err != context.DeadlineExceeded {
+		t.Fatalf("expected %v, got %v", context.DeadlineExceeded, err)
+	}
+
+	resp, err := cli.Get(context.TODO(), "test-mutex", clientv3.WithPrefix())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Kvs) != 1 || string(resp.Kvs[0].Key) != m1.Key() {
+		t.Fatalf("expected only %q to remain, got %+v", m1.Key(), resp.Kvs)
+	}
+}
+
 func BenchmarkMutex4Waiters(b *testing.B) {
 	// XXX switch tests to use TB interface
 	clus := NewClusterV3(nil, &ClusterConfig{Size: 3})
@@ -113,6 +113,41 @@ func TestSTMPutNewKey(t *testing.T) {
 	}
 }
 
+// TestSTMMaxAttempts tests that a txn that keeps conflicting gives up after
+// the given number of attempts.
+func TestSTMMaxAttempts(t *testing.T) {
+	clus := NewClusterV3(t, &ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	etcdc := clus.RandClient()
+	attempts := 0
+	applyf := func(stm concurrency.STM) error {
+		attempts++
+		stm.Get("foo")
+		// invalidate the read set before the txn commits
+		if _, err := etcdc.Put(context.TODO(), "foo", fmt.Sprintf("%d", attempts)); err != nil {
+			return err
+		}
+		stm.Put("foo", "bar")
+		return nil
+	}
+	_, err := concurrency.NewSTMRepeatable(context.TODO(), etcdc, applyf, concurrency.WithMaxAttempts(3))
+	if err != concurrency.ErrSTMConflict {
+		t.Fatalf("expected %v, got %v", concurrency.ErrSTMConflict, err)
+	}
+	if attempts != 3 {
+		t.Fatalf("expected 3 attempts, got %d", attempts)
+	}
+
+	resp, err := etcdc.Get(context.TODO(), "foo")
+	if err != nil {
+		t.Fatalf("error fetching key (%v)", err)
+	}
+	if string(resp.Kvs[0].Value) != "3" {
+		t.Fatalf("bad value. got %+v, expected '3' value", resp)
+	}
+}
+
 // TestSTMAbort tests that an aborted txn does not modify any keys.
 func TestSTMAbort(t *testing.T) {
 	clus := NewClusterV3(t, &ClusterConfig{Size: 1})
@@ -197,3 +232,55 @@ func TestSTMSerialize(t *testing.T) {
 		}
 	}
 }
+
+// TestSTMIncrement tests that concurrent increments are all applied.
+func TestSTMIncrement(t *testing.T) {
+	clus := NewClusterV3(t, &ClusterConfig{Size: 3})
+	defer clus.Terminate(t)
+
+	const (
+		clients = 5
+		incs    = 10
+	)
+	errc := make(chan error)
+	for i := 0; i < clients; i++ {
+		etcdc := clus.RandClient()
+		go func() {
+			for j := 0; j < incs; j++ {
+				if _, err := concurrency.Increment(context.TODO(), etcdc, "cnt", 2); err != nil {
+					errc <- err
+					return
+				}
+			}
+			errc <- nil
+		}()
+	}
+	for i := 0; i < clients; i++ {
+		if err := <-errc; err != nil {
+			t.Fatalf("increment failed (%v)", err)
+		}
+	}
+
+	n, err := concurrency.Increment(context.TODO(), clus.RandClient(), "cnt", -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if w := int64(clients*incs*2 - 1); n != w {
+		t.Errorf("counter = %d, want %d", n, w)
+	}
+
+	etcdc := clus.RandClient()
+	if _, err := etcdc.Put(context.TODO(), "cnt", "abc"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := concurrency.Increment(context.TODO(), etcdc, "cnt", 1); err == nil {
+		t.Fatalf("expected error on non-integer counter")
+	}
+	resp, err := etcdc.Get(context.TODO(), "cnt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(resp.Kvs[0].Value) != "abc" {
+		t.Errorf("value = %q, want unchanged %q", resp.Kvs[0].Value, "abc")
+	}
+}
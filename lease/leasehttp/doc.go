@@ -12,5 +12,5 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-// Package leasehttp serves lease renewals made through HTTP requests.
+// Package leasehttp serves lease renewals and time-to-live lookups made through HTTP requests.
 package leasehttp
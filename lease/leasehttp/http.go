@@ -25,7 +25,10 @@ import (
 	"github.com/coreos/etcd/lease"
 )
 
-// NewHandler returns an http Handler for lease renewals
+// LeaseTTLPrefix is the path for lease time-to-live lookups.
+const LeaseTTLPrefix = "/leases/ttl"
+
+// NewHandler returns an http Handler for lease renewals and time-to-live lookups
 func NewHandler(l lease.Lessor) http.Handler {
 	return &leaseHandler{l}
 }
@@ -44,6 +47,11 @@ func (h *leaseHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if r.URL.Path == LeaseTTLPrefix {
+		h.serveTimeToLive(w, b)
+		return
+	}
+
 	lreq := pb.LeaseKeepAliveRequest{}
 	if err := lreq.Unmarshal(b); err != nil {
 		http.Error(w, "error unmarshalling request", http.StatusBadRequest)
@@ -73,6 +81,42 @@ func (h *leaseHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	w.Write(v)
 }
 
+func (h *leaseHandler) serveTimeToLive(w http.ResponseWriter, b []byte) {
+	lreq := pb.LeaseTimeToLiveRequest{}
+	if err := lreq.Unmarshal(b); err != nil {
+		http.Error(w, "error unmarshalling request", http.StatusBadRequest)
+		return
+	}
+
+	ttl, granted, items, err := h.l.TimeToLive(lease.LeaseID(lreq.ID))
+	if err != nil {
+		if err == lease.ErrLeaseNotFound {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// TODO: fill out ResponseHeader
+	resp := &pb.LeaseTimeToLiveResponse{ID: lreq.ID, TTL: ttl, GrantedTTL: granted}
+	if lreq.Keys {
+		resp.Keys = make([][]byte, len(items))
+		for i := range items {
+			resp.Keys[i] = []byte(items[i].Key)
+		}
+	}
+	v, err := resp.Marshal()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/protobuf")
+	w.Write(v)
+}
+
 // RenewHTTP renews a lease at a given primary server.
 // TODO: Batch request in future?
 func RenewHTTP(id lease.LeaseID, url string, rt http.RoundTripper, timeout time.Duration) (int64, error) {
@@ -110,3 +154,38 @@ func RenewHTTP(id lease.LeaseID, url string, rt http.RoundTripper, timeout time.
 	}
 	return lresp.TTL, nil
 }
+
+// TimeToLiveHTTP retrieves lease information of the given lease ID at a given primary server.
+func TimeToLiveHTTP(id lease.LeaseID, keys bool, url string, rt http.RoundTripper, timeout time.Duration) (*pb.LeaseTimeToLiveResponse, error) {
+	lreq, err := (&pb.LeaseTimeToLiveRequest{ID: int64(id), Keys: keys}).Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	cc := &http.Client{Transport: rt, Timeout: timeout}
+	resp, err := cc.Post(url, "application/protobuf", bytes.NewReader(lreq))
+	if err != nil {
+		return nil, err
+	}
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, lease.ErrLeaseNotFound
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("lease: unknown error(%s)", string(b))
+	}
+
+	lresp := &pb.LeaseTimeToLiveResponse{}
+	if err := lresp.Unmarshal(b); err != nil {
+		return nil, fmt.Errorf(`lease: %v. data = "%s"`, err, string(b))
+	}
+	if lresp.ID != int64(id) {
+		return nil, fmt.Errorf("lease: time to live id mismatch")
+	}
+	return lresp, nil
+}
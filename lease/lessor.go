@@ -91,6 +91,14 @@ type Lessor interface {
 	// Lookup gives the lease at a given lease id, if any
 	Lookup(id LeaseID) *Lease
 
+	// TimeToLive returns the remaining TTL in seconds of the lease with given ID,
+	// along with its granted TTL and the items attached to it. If the ID does not
+	// exist, an error will be returned.
+	TimeToLive(id LeaseID) (ttl, grantedTTL int64, items []LeaseItem, err error)
+
+	// Leases lists all leases.
+	Leases() []*Lease
+
 	// ExpiredLeasesC returns a chan that is used to receive expired leases.
 	ExpiredLeasesC() <-chan []*Lease
 
@@ -254,6 +262,39 @@ func (le *lessor) Lookup(id LeaseID) *Lease {
 	return nil
 }
 
+// TimeToLive returns the remaining TTL of an existing lease. Only the primary
+// lessor keeps the expiry of leases, so others return ErrNotPrimary.
+func (le *lessor) TimeToLive(id LeaseID) (int64, int64, []LeaseItem, error) {
+	le.mu.Lock()
+	defer le.mu.Unlock()
+
+	if !le.primary {
+		return -1, -1, nil, ErrNotPrimary
+	}
+
+	l := le.leaseMap[id]
+	if l == nil {
+		return -1, -1, nil, ErrLeaseNotFound
+	}
+
+	items := make([]LeaseItem, 0, len(l.itemSet))
+	for item := range l.itemSet {
+		items = append(items, item)
+	}
+	return int64(l.expiry.Sub(time.Now()).Seconds()), l.TTL, items, nil
+}
+
+func (le *lessor) Leases() []*Lease {
+	le.mu.Lock()
+	defer le.mu.Unlock()
+
+	ls := make([]*Lease, 0, len(le.leaseMap))
+	for _, l := range le.leaseMap {
+		ls = append(ls, l)
+	}
+	return ls
+}
+
 func (le *lessor) Promote(extend time.Duration) {
 	le.mu.Lock()
 	defer le.mu.Unlock()
@@ -500,6 +541,12 @@ func (fl *FakeLessor) Renew(id LeaseID) (int64, error) { return 10, nil }
 
 func (le *FakeLessor) Lookup(id LeaseID) *Lease { return nil }
 
+func (fl *FakeLessor) TimeToLive(id LeaseID) (int64, int64, []LeaseItem, error) {
+	return 10, 10, nil, nil
+}
+
+func (fl *FakeLessor) Leases() []*Lease { return nil }
+
 func (fl *FakeLessor) ExpiredLeasesC() <-chan []*Lease { return nil }
 
 func (fl *FakeLessor) Recover(b backend.Backend, rd RangeDeleter) {}
@@ -151,6 +151,45 @@ func TestLessorRenew(t *testing.T) {
 	}
 }
 
+// TestLessorTimeToLive ensures the primary Lessor reports the remaining
+// TTL and attached items of a lease.
+func TestLessorTimeToLive(t *testing.T) {
+	dir, be := NewTestBackend(t)
+	defer os.RemoveAll(dir)
+	defer be.Close()
+
+	le := newLessor(be)
+	l, err := le.Grant(1, 100)
+	if err != nil {
+		t.Fatalf("could not grant lease for 100s ttl (%v)", err)
+	}
+	if _, _, _, err = le.TimeToLive(l.ID); err != ErrNotPrimary {
+		t.Fatalf("err = %v, want %v", err, ErrNotPrimary)
+	}
+
+	le.Promote(0)
+	if err = le.Attach(l.ID, []LeaseItem{{"foo"}}); err != nil {
+		t.Fatalf("failed to attach items to the lease: %v", err)
+	}
+	ttl, granted, items, err := le.TimeToLive(l.ID)
+	if err != nil {
+		t.Fatalf("failed to get lease ttl (%v)", err)
+	}
+	if ttl < 98 || ttl > 100 {
+		t.Errorf("ttl = %d, want in [98, 100]", ttl)
+	}
+	if granted != 100 {
+		t.Errorf("granted ttl = %d, want 100", granted)
+	}
+	if !reflect.DeepEqual(items, []LeaseItem{{"foo"}}) {
+		t.Errorf("items = %+v, want [{foo}]", items)
+	}
+
+	if _, _, _, err = le.TimeToLive(2); err != ErrLeaseNotFound {
+		t.Errorf("err = %v, want %v", err, ErrLeaseNotFound)
+	}
+}
+
 func TestLessorDetach(t *testing.T) {
 	dir, be := NewTestBackend(t)
 	defer os.RemoveAll(dir)
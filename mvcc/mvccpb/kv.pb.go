@@ -89,6 +89,8 @@ type Event struct {
 	// A DELETE/EXPIRE event contains the deleted key with
 	// its modification revision set to the revision of deletion.
 	Kv *KeyValue `protobuf:"bytes,2,opt,name=kv" json:"kv,omitempty"`
+	// prev_kv holds the key-value pair before the event happens.
+	PrevKv *KeyValue `protobuf:"bytes,3,opt,name=prev_kv,json=prevKv" json:"prev_kv,omitempty"`
 }
 
 func (m *Event) Reset()                    { *m = Event{} }
@@ -181,6 +183,16 @@ func (m *Event) MarshalTo(data []byte) (int, error) {
 		}
 		i += n1
 	}
+	if m.PrevKv != nil {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintKv(data, i, uint64(m.PrevKv.Size()))
+		n2, err := m.PrevKv.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
 	return i, nil
 }
 
@@ -247,6 +259,10 @@ func (m *Event) Size() (n int) {
 		l = m.Kv.Size()
 		n += 1 + l + sovKv(uint64(l))
 	}
+	if m.PrevKv != nil {
+		l = m.PrevKv.Size()
+		n += 1 + l + sovKv(uint64(l))
+	}
 	return n
 }
 
@@ -532,6 +548,39 @@ func (m *Event) Unmarshal(data []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevKv", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKv
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKv
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PrevKv == nil {
+				m.PrevKv = &KeyValue{}
+			}
+			if err := m.PrevKv.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKv(data[iNdEx:])
@@ -659,23 +708,23 @@ var (
 )
 
 var fileDescriptorKv = []byte{
-	// 274 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x44, 0x90, 0xc1, 0x4a, 0xc3, 0x40,
-	0x10, 0x86, 0xbb, 0x4d, 0x93, 0xd6, 0x69, 0xa9, 0x61, 0x09, 0xb8, 0x78, 0x28, 0x31, 0x17, 0x05,
-	0x21, 0x42, 0x7d, 0x03, 0x31, 0x27, 0x3d, 0xc8, 0x12, 0xbd, 0x4a, 0x1a, 0x87, 0x52, 0xd2, 0x76,
-	0x43, 0x1a, 0x17, 0xf3, 0x52, 0x3e, 0x47, 0x8f, 0x7d, 0x04, 0xf5, 0x49, 0xdc, 0xcc, 0x9a, 0x7a,
-	0x98, 0x65, 0xe6, 0xff, 0xfe, 0x65, 0xff, 0x59, 0x18, 0x15, 0x3a, 0x2e, 0x2b, 0x55, 0x2b, 0xee,
-	0x6d, 0x74, 0x9e, 0x97, 0x8b, 0xf3, 0x60, 0xa9, 0x96, 0x8a, 0xa4, 0x9b, 0xb6, 0xb3, 0x34, 0xfa,
-	0x64, 0x30, 0x7a, 0xc0, 0xe6, 0x25, 0x5b, 0xbf, 0x23, 0xf7, 0xc1, 0x29, 0xb0, 0x11, 0x2c, 0x64,
-	0x57, 0x13, 0xd9, 0xb6, 0xfc, 0x12, 0x4e, 0xf3, 0x0a, 0xb3, 0x1a, 0x5f, 0x2b, 0xd4, 0xab, 0xdd,
-	0x4a, 0x6d, 0x45, 0xdf, 0x50, 0x47, 0x4e, 0xad, 0x2c, 0xff, 0x54, 0x7e, 0x01, 0x93, 0x8d, 0x7a,
-	0xfb, 0x77, 0x39, 0xe4, 0x1a, 0x1b, 0xed, 0x68, 0x11, 0x30, 0xd4, 0x58, 0x11, 0x1d, 0x10, 0xed,
-	0x46, 0x1e, 0x80, 0xab, 0xdb, 0x00, 0xc2, 0xa5, 0x97, 0xed, 0xd0, 0xaa, 0x6b, 0xcc, 0x76, 0x28,
-	0x3c, 0x72, 0xdb, 0x21, 0xfa, 0x00, 0x37, 0xd1, 0xb8, 0xad, 0xf9, 0x35, 0x0c, 0xea, 0xa6, 0x44,
-	0x4a, 0x3b, 0x9d, 0x9f, 0xc5, 0x76, 0xcd, 0x98, 0xa0, 0x3d, 0x53, 0x83, 0x25, 0x99, 0x78, 0x08,
-	0xfd, 0x42, 0x53, 0xf4, 0xf1, 0xdc, 0xef, 0xac, 0xdd, 0xde, 0xd2, 0xb0, 0x28, 0x84, 0x93, 0xe3,
-	0x25, 0x3e, 0x04, 0xe7, 0xe9, 0x39, 0xf5, 0x7b, 0x1c, 0xc0, 0xbb, 0x4f, 0x1e, 0x93, 0x34, 0xf1,
-	0xd9, 0x5d, 0xb0, 0xff, 0x9e, 0xf5, 0x0e, 0xa6, 0xf6, 0x3f, 0x33, 0x76, 0x30, 0xf5, 0x65, 0x6a,
-	0xe1, 0xd1, 0x3f, 0xde, 0xfe, 0x06, 0x00, 0x00, 0xff, 0xff, 0x71, 0xd2, 0x34, 0xa9, 0x71, 0x01,
-	0x00, 0x00,
+	// 288 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x75, 0x90, 0xc1, 0x4a, 0xc3, 0x40,
+	0x10, 0x86, 0xbb, 0x4d, 0x93, 0xd4, 0x69, 0xa9, 0x61, 0x09, 0x18, 0x3c, 0x94, 0x98, 0x8b, 0x8a,
+	0x10, 0xa1, 0xbe, 0x41, 0x31, 0xa7, 0x7a, 0x90, 0x10, 0xbd, 0x96, 0x34, 0x0e, 0xa5, 0xa4, 0xed,
+	0x86, 0x34, 0x2e, 0xe4, 0x79, 0xbc, 0xfb, 0x1c, 0x3d, 0xf6, 0x11, 0xac, 0x4f, 0xe2, 0x66, 0xd6,
+	0xd4, 0x93, 0x87, 0x59, 0x66, 0xfe, 0xff, 0x63, 0xf7, 0x9f, 0x85, 0x7e, 0x2e, 0xc3, 0xa2, 0x14,
+	0x95, 0xe0, 0xd6, 0x46, 0x66, 0x59, 0xb1, 0xb8, 0x74, 0x97, 0x62, 0x29, 0x48, 0xba, 0x6f, 0x3a,
+	0xed, 0x06, 0x9f, 0x0c, 0xfa, 0x33, 0xac, 0x5f, 0xd3, 0xf5, 0x3b, 0x72, 0x07, 0x8c, 0x1c, 0x6b,
+	0x8f, 0xf9, 0xec, 0x66, 0x18, 0x37, 0x2d, 0xbf, 0x86, 0xf3, 0xac, 0xc4, 0xb4, 0xc2, 0x79, 0x89,
+	0x72, 0xb5, 0x5b, 0x89, 0xad, 0xd7, 0x55, 0xae, 0x11, 0x8f, 0xb4, 0x1c, 0xff, 0xaa, 0xfc, 0x0a,
+	0x86, 0x1b, 0xf1, 0xf6, 0x47, 0x19, 0x44, 0x0d, 0x94, 0x76, 0x42, 0x3c, 0xb0, 0x25, 0x96, 0xe4,
+	0xf6, 0xc8, 0x6d, 0x47, 0xee, 0x82, 0x29, 0x9b, 0x00, 0x9e, 0x49, 0x2f, 0xeb, 0xa1, 0x51, 0xd7,
+	0x98, 0xee, 0xd0, 0xb3, 0x88, 0xd6, 0x43, 0xf0, 0xc1, 0xc0, 0x8c, 0x24, 0x6e, 0x2b, 0x7e, 0x07,
+	0xbd, 0xaa, 0x2e, 0x90, 0xe2, 0x8e, 0x26, 0x17, 0xa1, 0xde, 0x33, 0x24, 0x53, 0x9f, 0x89, 0xb2,
+	0x63, 0x82, 0xb8, 0x0f, 0xdd, 0x5c, 0x52, 0xf6, 0xc1, 0xc4, 0x69, 0xd1, 0x76, 0xf1, 0x58, 0x79,
+	0xfc, 0x16, 0xec, 0x42, 0xc5, 0x9f, 0x2b, 0xcc, 0xf8, 0x07, 0xb3, 0x1a, 0x60, 0x26, 0x03, 0x1f,
+	0xce, 0x4e, 0xf7, 0x73, 0x1b, 0x8c, 0xe7, 0x97, 0xc4, 0xe9, 0x70, 0x00, 0xeb, 0x31, 0x7a, 0x8a,
+	0x92, 0xc8, 0x61, 0x53, 0x77, 0x7f, 0x1c, 0x77, 0x0e, 0xaa, 0xf6, 0xdf, 0x63, 0x76, 0x50, 0xf5,
+	0xa5, 0x6a, 0x61, 0xd1, 0x9f, 0x3f, 0xfc, 0x00, 0xbb, 0x86, 0x36, 0x07, 0x9d, 0x01, 0x00, 0x00,
 }
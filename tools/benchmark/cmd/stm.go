@@ -45,7 +45,7 @@ var (
 	stmKeyCount     int
 	stmValSize      int
 	stmWritePercent int
-	mkSTM           func(context.Context, *v3.Client, func(v3sync.STM) error) (*v3.TxnResponse, error)
+	mkSTM           func(context.Context, *v3.Client, func(v3sync.STM) error, ...v3sync.STMOption) (*v3.TxnResponse, error)
 )
 
 func init() {